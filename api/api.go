@@ -45,6 +45,9 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 		r.PUT("/refresh", h.RefreshToken)
 		r.POST("/has-access", h.HasAccess)
 
+		r.GET("/reviewer/invitation", h.ReviewerInvitationPage)
+		r.POST("/reviewer/invitation", h.RespondReviewerInvitation)
+
 		r.PUT("/profile", h.UpdateProfile)
 		r.GET("/profile/:profile-id", h.GetProfileByID)
 
@@ -54,6 +57,7 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 		r.GET("/general/journal/:journal-id", h.GetGeneralJournalByID)
 		r.GET("/general/journal/:journal-id/author", h.GetGeneralJournalAuthorList)
 		r.GET("/general/journal/:journal-id/author/:author-id", h.GetGeneralJournalAuthorByID)
+		r.GET("/search", h.Search)
 	}
 
 	// auth
@@ -67,30 +71,44 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 	//r.POST("/send-verification-message", h.SendVerificationMessage)
 	//r.PUT("/verification", h.EmailVerification)
 
-	r.POST("/user/:user-id/draft", h.CreateUserDraft)
-	r.GET("/user/:user-id/draft", h.GetUserDraftList)
-	r.GET("/user/:user-id/draft/:draft-id", h.GetUserDraftByID)
-	r.PUT("/user/:user-id/draft", h.UpdateUserDraft)
-	r.DELETE("/user/:user-id/draft/:draft-id", h.DeleteUserDraft)
-
-	r.POST("/user/:user-id/draft/:draft-id/file", h.AddDraftFile)
-	r.DELETE("/user/:user-id/draft/:draft-id/file/:file-id", h.DeleteDraftFiles)
-
-	r.POST("/user/:user-id/draft/:draft-id/coauthor", h.AddDraftCoAuthors)
-	r.DELETE("/user/:user-id/draft/:draft-id/coauthor/:coauthor-id", h.DeleteDraftCoAuthors)
-
-	r.GET("/user/:user-id/draft/:draft-id/check", h.GetUserDraftCheckList)
-	r.GET("/user/:user-id/draft/:draft-id/check/:check-id", h.GetUserDraftCheckByID)
-
-	r.GET("/user/:user-id/review", h.GetUserReviewList)
-	r.GET("/user/:user-id/review/:review-id", h.GetUserReviewByID)
-	r.PUT("/user/:user-id/review", h.UpdateUserReview)
+	{
+		// user
+		user := r.Group("/user/:user-id", h.AuthMiddleware(), h.UserMiddleware())
+		user.POST("/draft", h.CreateUserDraft)
+		user.GET("/draft", h.GetUserDraftList)
+		user.GET("/draft/:draft-id", h.GetUserDraftByID)
+		user.PUT("/draft", h.UpdateUserDraft)
+		user.DELETE("/draft/:draft-id", h.DeleteUserDraft)
+		user.GET("/draft/:draft-id/history", h.GetUserDraftHistory)
+		user.POST("/draft/:draft-id/revision", h.SubmitUserDraftRevision)
+		user.GET("/draft/:draft-id/revisions", h.GetUserDraftRevisions)
+
+		user.POST("/draft/:draft-id/file", h.AddDraftFile)
+		user.DELETE("/draft/:draft-id/file/:file-id", h.DeleteDraftFiles)
+
+		user.POST("/draft/:draft-id/coauthor", h.AddDraftCoAuthors)
+		user.DELETE("/draft/:draft-id/coauthor/:coauthor-id", h.DeleteDraftCoAuthors)
+
+		user.GET("/draft/:draft-id/check", h.GetUserDraftCheckList)
+		user.GET("/draft/:draft-id/check/:check-id", h.GetUserDraftCheckByID)
+
+		user.GET("/review", h.GetUserReviewList)
+		user.GET("/review/:review-id", h.GetUserReviewByID)
+		user.PUT("/review", h.UpdateUserReview)
+		user.GET("/review/:review-id/form", h.GetUserReviewForm)
+
+		user.GET("/search", h.SearchUser)
+
+		user.GET("/notifications", h.GetUserNotificationList)
+		user.PUT("/notifications/read", h.MarkAllUserNotificationsRead)
+		user.PUT("/notifications/:notification-id/read", h.MarkUserNotificationRead)
+	}
 
 	{
 		// journal
-		journal := r.Group("/journal")
+		journal := r.Group("/journal", h.AuthMiddleware(), h.JournalMiddleware())
 		journal.GET("/:journal-id", h.GetJournalByID)
-		journal.PUT("", h.UpdateJournal)
+		journal.PUT("/:journal-id", h.UpdateJournal)
 		journal.DELETE("/:journal-id", h.DeleteJournal) // @TODO
 
 		// journal author
@@ -104,6 +122,8 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 		journal.GET("/:journal-id/draft", h.GetJournalDraftList)
 		journal.GET("/:journal-id/draft/:draft-id", h.GetJournalDraftByID)
 		journal.PUT("/:journal-id/draft", h.UpdateJournalDraft)
+		journal.GET("/:journal-id/draft/:draft-id/history", h.GetJournalDraftHistory)
+		journal.POST("/:journal-id/draft/:draft-id/decision-letter", h.PreviewJournalDraftDecisionLetter)
 		//journal.DELETE("/:journal-id/draft/:draft-id", h.DeleteJournalArticle)
 
 		journal.POST("/:journal-id/draft/:draft-id/check", h.CreateArticleCheck)
@@ -114,8 +134,16 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 
 		journal.POST("/:journal-id/draft/:draft-id/reviewer", h.CreateArticleReviewer)
 		journal.DELETE("/:journal-id/draft/:draft-id/reviewer/:reviewer-id", h.DeleteArticleReviewer)
+		journal.GET("/:journal-id/draft/:draft-id/reviewer/suggestions", h.GetArticleReviewerSuggestions)
 		journal.GET("/:journal-id/draft/:draft-id/review", h.GetArticleReviewList)
 		journal.GET("/:journal-id/draft/:draft-id/review/:review-id", h.GetArticleReviewByID)
+		journal.GET("/:journal-id/review", h.GetJournalReviewList)
+
+		journal.POST("/:journal-id/review-form", h.CreateReviewForm)
+		journal.GET("/:journal-id/review-form", h.GetReviewFormList)
+		journal.GET("/:journal-id/review-form/:review-form-id", h.GetReviewFormByID)
+		journal.PUT("/:journal-id/review-form", h.UpdateReviewForm)
+		journal.DELETE("/:journal-id/review-form/:review-form-id", h.DeleteReviewForm)
 
 		journal.POST("/:journal-id/article", h.CreateJournalArticle)
 		journal.GET("/:journal-id/article", h.GetJournalArticleList)
@@ -123,6 +151,9 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 		journal.PUT("/:journal-id/article", h.UpdateJournalArticle)
 		journal.DELETE("/:journal-id/article/:article-id", h.DeleteJournalArticle)
 
+		journal.GET("/:journal-id/search", h.SearchJournal)
+		journal.GET("/:journal-id/stats", h.GetJournalStats)
+
 		journal.POST("/:journal-id/edition", h.CreateEdition)
 		journal.GET("/:journal-id/edition", h.GetEditionList)
 		journal.GET("/:journal-id/edition/:edition-id", h.GetEditionByID)
@@ -139,7 +170,7 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 	}
 	{
 		// admin
-		admin := r.Group("/admin")
+		admin := r.Group("/admin", h.AuthMiddleware(), h.AdminMiddleware())
 		admin.POST("/journal", h.CreateAdminJournal)
 		admin.GET("/journal", h.GetAdminJournalList)
 		admin.GET("/journal/:journal-id", h.GetAdminJournalByID)
@@ -181,11 +212,20 @@ func SetUpRouter(h handlers.Handler, cfg config.Config) (r *gin.Engine) {
 		admin.PUT("/keyword", h.UpdateAdminKeyword)
 		admin.DELETE("/keyword/:keyword-id", h.DeleteAdminKeyword)
 
+		admin.POST("/permission", h.CreateAdminPermission)
+		admin.GET("/permission", h.GetAdminPermissionList)
+		admin.GET("/permission/:permission-id", h.GetAdminPermissionByID)
+		admin.PUT("/permission", h.UpdateAdminPermission)
+		admin.DELETE("/permission/:permission-id", h.DeleteAdminPermission)
+
 		admin.POST("/email/template", h.CreateAdminEmailTmp)
 		admin.GET("/email/template", h.GetAdminEmailTmpList)
 		admin.GET("/email/template/:template-id", h.GetAdminEmailTmpByID)
 		admin.PUT("/email/template", h.UpdateAdminEmailTmp)
 		admin.DELETE("/email/template/:template-id", h.DeleteAdminEmailTmp)
+		admin.POST("/email/template/:template-id/preview", h.PreviewAdminEmailTmp)
+
+		admin.GET("/stats", h.GetAdminStats)
 	}
 
 	// swagger
@@ -60,6 +60,10 @@ func (h *Handler) CreateJournalArticle(c *gin.Context) {
 // @Param date-from query string false "date-from"
 // @Param date-to query string false "date-to"
 // @Param sort query string false "sort"
+// @Param keyword-id query string false "keyword-id"
+// @Param cursor query string false "cursor"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=content_service.GetArticleListRes} "GetArticleListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -80,18 +84,22 @@ func (h *Handler) GetJournalArticleList(c *gin.Context) {
 	resp, err := h.services.ContentService().GetArticleList(
 		c.Request.Context(),
 		&content_service.GetArticleListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.Query("search"),
-			JournalId: c.Param("journal-id"),
-			DateFrom:  c.Query("date-from"),
-			DateTo:    c.Query("date-to"),
-			Sort:      c.Query("sort"),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.Query("search"),
+			JournalId:   c.Param("journal-id"),
+			DateFrom:    c.Query("date-from"),
+			DateTo:      c.Query("date-to"),
+			Sort:        c.Query("sort"),
+			KeywordId:   c.Query("keyword-id"),
+			Cursor:      c.Query("cursor"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -220,6 +228,10 @@ func (h *Handler) DeleteJournalArticle(c *gin.Context) {
 // @Param date-from query string false "date-from"
 // @Param date-to query string false "date-to"
 // @Param sort query string false "sort"
+// @Param keyword-id query string false "keyword-id"
+// @Param cursor query string false "cursor"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=content_service.GetArticleListRes} "GetArticleListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -240,17 +252,21 @@ func (h *Handler) GetGeneralArticleList(c *gin.Context) {
 	resp, err := h.services.ContentService().GetArticleList(
 		c.Request.Context(),
 		&content_service.GetArticleListReq{
-			Limit:    int32(limit),
-			Offset:   int32(offset),
-			Search:   c.Query("search"),
-			DateFrom: c.Query("date-from"),
-			DateTo:   c.Query("date-to"),
-			Sort:     c.Query("sort"),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.Query("search"),
+			DateFrom:    c.Query("date-from"),
+			DateTo:      c.Query("date-to"),
+			Sort:        c.Query("sort"),
+			KeywordId:   c.Query("keyword-id"),
+			Cursor:      c.Query("cursor"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -22,6 +22,9 @@ import (
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=auth_service.GetUserListRes} "GetEditorListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -41,15 +44,18 @@ func (h *Handler) GetAdminAuthorList(c *gin.Context) {
 	resp, err := h.services.UserService().GetUserListByRole(
 		c.Request.Context(),
 		&auth_service.GetUserListByRoleReq{
-			Limit:    int32(limit),
-			Offset:   int32(offset),
-			Search:   c.DefaultQuery("search", ""),
-			RoleType: config.AUTHOR,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			RoleType:    config.AUTHOR,
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -213,6 +219,9 @@ func (h *Handler) UpdateAdminAuthor(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=content_service.GetJournalAuthorListRes} "GetEditorListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -239,15 +248,18 @@ func (h *Handler) GetJournalAuthorList(c *gin.Context) {
 	resp, err := h.services.ContentService().GetJournalAuthorList(
 		c.Request.Context(),
 		&content_service.GetJournalAuthorListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			JournalId: journalId,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			JournalId:   journalId,
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -438,6 +450,9 @@ func (h *Handler) DeleteJournalAuthor(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=content_service.GetJournalAuthorListRes} "GetEditorListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -464,15 +479,18 @@ func (h *Handler) GetGeneralJournalAuthorList(c *gin.Context) {
 	resp, err := h.services.ContentService().GetJournalAuthorList(
 		c.Request.Context(),
 		&content_service.GetJournalAuthorListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			JournalId: journalId,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			JournalId:   journalId,
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"editory_submission/api/models"
 	"editory_submission/config"
 	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/submission"
 	"editory_submission/pkg/util"
 	"errors"
 	"github.com/gin-gonic/gin"
@@ -20,12 +21,15 @@ import (
 // @Produce json
 // @Param user-id path string true "user-id"
 // @Param draft-id path string true "draft-id"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=pb.GetArticleCheckerListRes} "GetArticleReviewerListRes"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) GetUserDraftCheckList(c *gin.Context) {
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
 		return
@@ -37,6 +41,10 @@ func (h *Handler) GetUserDraftCheckList(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	offset, err := h.getOffsetParam(c)
 	if err != nil {
 		h.handleResponse(c, http.InvalidArgument, err.Error())
@@ -52,16 +60,20 @@ func (h *Handler) GetUserDraftCheckList(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleCheckerList(
 		c.Request.Context(),
 		&pb.GetArticleCheckerListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			ArticleId: draftId,
-			Type:      config.EDITOR,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			ArticleId:   draftId,
+			Type:        config.EDITOR,
+			ViewerId:    h.getUserId(c),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -84,7 +96,7 @@ func (h *Handler) GetUserDraftCheckList(c *gin.Context) {
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) GetUserDraftCheckByID(c *gin.Context) {
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
 		return
@@ -96,6 +108,10 @@ func (h *Handler) GetUserDraftCheckByID(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	checkId := c.Param("check-id")
 	if !util.IsValidUUID(checkId) {
 		h.handleResponse(c, http.InvalidArgument, "check id is an invalid uuid")
@@ -105,7 +121,8 @@ func (h *Handler) GetUserDraftCheckByID(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleChecker(
 		c.Request.Context(),
 		&pb.GetArticleCheckerReq{
-			Id: checkId,
+			Id:       checkId,
+			ViewerId: userId,
 		},
 	)
 
@@ -114,6 +131,11 @@ func (h *Handler) GetUserDraftCheckByID(c *gin.Context) {
 		return
 	}
 
+	if resp.GetArticleId() != draftId {
+		h.handleResponse(c, http.NotFound, "check of the draft not found")
+		return
+	}
+
 	h.handleResponse(c, http.OK, resp)
 }
 
@@ -151,6 +173,10 @@ func (h *Handler) CreateArticleCheck(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
+		return
+	}
+
 	err := c.ShouldBindJSON(&check)
 	if err != nil {
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -169,12 +195,13 @@ func (h *Handler) CreateArticleCheck(c *gin.Context) {
 	resp, err := h.services.CheckerService().CreateArticleChecker(
 		c.Request.Context(),
 		&pb.CreateArticleCheckerReq{
-			CheckerId: check.EditorId,
+			CheckerId: h.getUserId(c),
 			ArticleId: articleId,
 			Status:    check.Status,
 			Type:      config.EDITOR,
 			Comment:   check.Comment,
 			Comments:  fileComments,
+			EditorId:  h.getUserId(c),
 		},
 	)
 
@@ -183,36 +210,20 @@ func (h *Handler) CreateArticleCheck(c *gin.Context) {
 		return
 	}
 
-	draftStatus := ""
-	draftStep := ""
-
-	switch check.Status {
-	case config.ARTICLE_EDITOR_STATUS_REJECTED:
-		draftStatus = config.ARTICLE_STATUS_DENIED
-		draftStep = config.DRAFT_STEP_AUTHOR
-	case config.ARTICLE_EDITOR_STATUS_REJECTED_WITH_CORRECTION:
-		draftStatus = config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION
-		draftStep = config.DRAFT_STEP_AUTHOR
-	case config.ARTICLE_EDITOR_STATUS_APPROVED:
-		draftStatus = config.ARTICLE_STATUS_CONFIRMED
-		draftStep = config.DRAFT_STEP_AUTHOR
-	case config.ARTICLE_EDITOR_STATUS_APPROVED_WITH_CORRECTION:
-		draftStatus = config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION
-		draftStep = config.DRAFT_STEP_AUTHOR
-	default:
-		draftStatus = config.ARTICLE_STATUS_PENDING
-	}
-
-	_, err = h.services.ArticleService().UpdateArticle(
-		c.Request.Context(),
-		&pb.UpdateArticleReq{
-			Status: draftStatus,
-			Id:     articleId,
-			Step:   draftStep,
-		})
-	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
-		return
+	if draftStatus, ok := submission.EditorDecision(check.Status); ok {
+		_, err = h.services.ArticleService().UpdateArticle(
+			c.Request.Context(),
+			&pb.UpdateArticleReq{
+				Status:   draftStatus,
+				Id:       articleId,
+				RoleType: config.EDITOR,
+				ActorId:  h.getUserId(c),
+				Comment:  check.Comment,
+			})
+		if err != nil {
+			h.handleResponse(c, http.GRPCError, err.Error())
+			return
+		}
 	}
 
 	h.handleResponse(c, http.Created, resp)
@@ -252,6 +263,10 @@ func (h *Handler) UpdateArticleCheck(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
+		return
+	}
+
 	err := c.ShouldBindJSON(&check)
 	if err != nil {
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -275,6 +290,7 @@ func (h *Handler) UpdateArticleCheck(c *gin.Context) {
 			ArticleId: articleId,
 			Comment:   check.Comment,
 			Comments:  fileComments,
+			ActorId:   h.getUserId(c),
 		},
 	)
 
@@ -283,36 +299,20 @@ func (h *Handler) UpdateArticleCheck(c *gin.Context) {
 		return
 	}
 
-	draftStatus := ""
-	draftStep := ""
-
-	switch check.Status {
-	case config.ARTICLE_EDITOR_STATUS_REJECTED:
-		draftStatus = config.ARTICLE_STATUS_DENIED
-		draftStep = config.DRAFT_STEP_AUTHOR
-	case config.ARTICLE_EDITOR_STATUS_REJECTED_WITH_CORRECTION:
-		draftStatus = config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION
-		draftStep = config.DRAFT_STEP_AUTHOR
-	case config.ARTICLE_EDITOR_STATUS_APPROVED:
-		draftStatus = config.ARTICLE_STATUS_CONFIRMED
-		draftStep = config.DRAFT_STEP_AUTHOR
-	case config.ARTICLE_EDITOR_STATUS_APPROVED_WITH_CORRECTION:
-		draftStatus = config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION
-		draftStep = config.DRAFT_STEP_AUTHOR
-	default:
-		draftStatus = config.ARTICLE_STATUS_PENDING
-	}
-
-	_, err = h.services.ArticleService().UpdateArticle(
-		c.Request.Context(),
-		&pb.UpdateArticleReq{
-			Status: draftStatus,
-			Id:     articleId,
-			Step:   draftStep,
-		})
-	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
-		return
+	if draftStatus, ok := submission.EditorDecision(check.Status); ok {
+		_, err = h.services.ArticleService().UpdateArticle(
+			c.Request.Context(),
+			&pb.UpdateArticleReq{
+				Status:   draftStatus,
+				Id:       articleId,
+				RoleType: config.EDITOR,
+				ActorId:  h.getUserId(c),
+				Comment:  check.Comment,
+			})
+		if err != nil {
+			h.handleResponse(c, http.GRPCError, err.Error())
+			return
+		}
 	}
 
 	h.handleResponse(c, http.Created, resp)
@@ -345,6 +345,10 @@ func (h *Handler) DeleteArticleCheck(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleID); !ok {
+		return
+	}
+
 	checkID := c.Param("check-id")
 	if !util.IsValidUUID(checkID) {
 		h.handleResponse(c, http.InvalidArgument, "check id is an invalid uuid")
@@ -354,7 +358,8 @@ func (h *Handler) DeleteArticleCheck(c *gin.Context) {
 	_, err := h.services.CheckerService().DeleteArticleChecker(
 		c.Request.Context(),
 		&pb.DeleteArticleCheckerReq{
-			Id: checkID,
+			Id:        checkID,
+			ArticleId: articleID,
 		},
 	)
 	if err != nil {
@@ -378,6 +383,9 @@ func (h *Handler) DeleteArticleCheck(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=pb.GetArticleCheckerListRes} "GetArticleReviewerListRes"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -389,6 +397,10 @@ func (h *Handler) GetArticleCheckList(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
+		return
+	}
+
 	offset, err := h.getOffsetParam(c)
 	if err != nil {
 		h.handleResponse(c, http.InvalidArgument, err.Error())
@@ -404,16 +416,20 @@ func (h *Handler) GetArticleCheckList(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleCheckerList(
 		c.Request.Context(),
 		&pb.GetArticleCheckerListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			ArticleId: articleId,
-			Type:      config.EDITOR,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			ArticleId:   articleId,
+			Type:        config.EDITOR,
+			ViewerId:    h.getUserId(c),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -442,6 +458,10 @@ func (h *Handler) GetArticleCheckByID(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
+		return
+	}
+
 	checkId := c.Param("check-id")
 	if !util.IsValidUUID(checkId) {
 		h.handleResponse(c, http.InvalidArgument, "check id is an invalid uuid")
@@ -451,7 +471,8 @@ func (h *Handler) GetArticleCheckByID(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleChecker(
 		c.Request.Context(),
 		&pb.GetArticleCheckerReq{
-			Id: checkId,
+			Id:       checkId,
+			ViewerId: h.getUserId(c),
 		},
 	)
 
@@ -22,16 +22,18 @@ import (
 // @Produce json
 // @Param user-id path string true "user Id"
 // @Param draft body models.CreateUserDraftReq true "CreateDraftRequestBody"
-// @Success 201 {object} http.Response{data=submission_service.CreateArticleRes} "Draft data"
+// @Success 201 {object} http.Response{data=submission_service.GetArticleRes} "Draft data"
 // @Response 400 {object} http.Response{data=string} "Bad Request"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) CreateUserDraft(c *gin.Context) {
 	var (
 		article   models.CreateUserDraftReq
 		articlePB submission_service.CreateArticleReq
+		files     []*submission_service.SubmitDraftFile
+		coauthors []*submission_service.SubmitDraftCoAuthor
 	)
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 
 	err := c.ShouldBindJSON(&article)
 	if err != nil {
@@ -52,18 +54,32 @@ func (h *Handler) CreateUserDraft(c *gin.Context) {
 	}
 
 	articlePB.AuthorId = userId
+	articlePB.RoleType = config.AUTHOR
 
-	if articlePB.Status == config.ARTICLE_STATUS_DRAFT {
-		articlePB.Step = config.DRAFT_STEP_AUTHOR
-	} else if articlePB.Status == config.ARTICLE_STATUS_NEW {
-		articlePB.Step = config.DRAFT_STEP_EDITOR
-	} else if articlePB.Status == config.ARTICLE_STATUS_CORRECTED {
-		articlePB.Step = config.DRAFT_STEP_EDITOR
+	for _, val := range article.Files {
+		files = append(files, &submission_service.SubmitDraftFile{
+			Url:  val.Url,
+			Type: val.Type,
+		})
 	}
 
-	resp, err := h.services.ArticleService().CreateArticle(
+	for _, val := range article.Coauthors {
+		coauthors = append(coauthors, &submission_service.SubmitDraftCoAuthor{
+			Email:        val.Email,
+			FirstName:    val.FirstName,
+			LastName:     val.LastName,
+			UniversityId: val.UniversityId,
+			CountryId:    val.CountryId,
+		})
+	}
+
+	resp, err := h.services.ArticleService().SubmitDraft(
 		c.Request.Context(),
-		&articlePB,
+		&submission_service.SubmitDraftReq{
+			Draft:     &articlePB,
+			Files:     files,
+			Coauthors: coauthors,
+		},
 	)
 
 	if err != nil {
@@ -71,83 +87,6 @@ func (h *Handler) CreateUserDraft(c *gin.Context) {
 		return
 	}
 
-	for _, val := range article.Files {
-		_, err := h.services.ArticleService().AddFiles(
-			c.Request.Context(),
-			&submission_service.AddFilesReq{
-				Url:       val.Url,
-				Type:      val.Type,
-				ArticleId: resp.Id,
-			},
-		)
-
-		if err != nil {
-			h.handleResponse(c, http.GRPCError, err.Error())
-			return
-		}
-	}
-
-	for _, val := range article.Coauthors {
-		userPb, err := h.services.UserService().GetUser(
-			c.Request.Context(),
-			&auth_service.GetUserReq{
-				Email: val.Email,
-			},
-		)
-		if err != nil {
-			if util.IsErrNoRows(err) {
-				userPb, err = h.services.UserService().CreateUser(
-					c.Request.Context(),
-					&auth_service.User{
-						FirstName:    val.FirstName,
-						LastName:     val.LastName,
-						Email:        val.Email,
-						UniversityId: val.UniversityId,
-						CountryId:    val.CountryId,
-						Password:     config.DEFAULT_PASSWORD,
-					},
-				)
-
-				if err != nil {
-					h.handleResponse(c, http.GRPCError, err.Error())
-					return
-				}
-			} else {
-				h.handleResponse(c, http.GRPCError, err.Error())
-				return
-			}
-		}
-
-		role := auth_service.Role{
-			RoleType: config.AUTHOR,
-			UserId:   userPb.GetId(),
-		}
-
-		_, err = h.services.RoleService().CreateRole(
-			c.Request.Context(),
-			&role,
-		)
-		if err != nil {
-			if !util.IsErrDuplicateKey(err) {
-				h.handleResponse(c, http.GRPCError, err.Error())
-				return
-			}
-		}
-
-		_, err = h.services.ArticleService().AddCoAuthor(
-			c.Request.Context(),
-			&submission_service.AddCoAuthorReq{
-				ArticleId: resp.Id,
-				UserId:    userPb.Id,
-			},
-		)
-
-		if err != nil {
-			h.handleResponse(c, http.GRPCError, err.Error())
-			return
-		}
-	}
-
 	h.handleResponse(c, http.Created, resp)
 }
 
@@ -161,16 +100,18 @@ func (h *Handler) CreateUserDraft(c *gin.Context) {
 // @Produce json
 // @Param user-id path string true "user Id"
 // @Param draft body models.UpdateUserDraftReq true "UpdateDraftRequestBody"
-// @Success 200 {object} http.Response{data=submission_service.UpdateArticleRes} "Draft data"
+// @Success 200 {object} http.Response{data=submission_service.GetArticleRes} "Draft data"
 // @Response 400 {object} http.Response{data=string} "Bad Request"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) UpdateUserDraft(c *gin.Context) {
 	var (
 		articlePB submission_service.UpdateArticleReq
 		article   models.UpdateUserDraftReq
+		files     []*submission_service.SubmitDraftFile
+		coauthors []*submission_service.SubmitDraftCoAuthor
 	)
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 
 	err := c.ShouldBindJSON(&article)
 	if err != nil {
@@ -190,42 +131,51 @@ func (h *Handler) UpdateUserDraft(c *gin.Context) {
 		return
 	}
 
+	if !util.IsValidUUID(article.Id) {
+		h.handleResponse(c, http.InvalidArgument, "draft id is an invalid uuid")
+		return
+	}
+
+	if _, ok := h.getUserDraft(c, article.Id); !ok {
+		return
+	}
+
 	articlePB.AuthorId = userId
+	articlePB.ActorId = userId
+	articlePB.RoleType = config.AUTHOR
 
-	if articlePB.Status == config.ARTICLE_STATUS_DRAFT {
-		articlePB.Step = config.DRAFT_STEP_AUTHOR
-	} else if articlePB.Status == config.ARTICLE_STATUS_NEW {
-		articlePB.Step = config.DRAFT_STEP_EDITOR
-	} else if articlePB.Status == config.ARTICLE_STATUS_CORRECTED {
-		articlePB.Step = config.DRAFT_STEP_EDITOR
+	for _, val := range article.Files {
+		files = append(files, &submission_service.SubmitDraftFile{
+			Url:  val.Url,
+			Type: val.Type,
+		})
 	}
 
-	resp, err := h.services.ArticleService().UpdateArticle(
+	for _, val := range article.Coauthors {
+		coauthors = append(coauthors, &submission_service.SubmitDraftCoAuthor{
+			Email:        val.Email,
+			FirstName:    val.FirstName,
+			LastName:     val.LastName,
+			UniversityId: val.UniversityId,
+			CountryId:    val.CountryId,
+		})
+	}
+
+	// the draft, its files and coauthors are saved in one transaction
+	resp, err := h.services.ArticleService().UpdateDraft(
 		c.Request.Context(),
-		&articlePB,
+		&submission_service.UpdateDraftReq{
+			Draft:     &articlePB,
+			Files:     files,
+			Coauthors: coauthors,
+		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
-	for _, val := range article.Files {
-		_, err := h.services.ArticleService().AddFiles(
-			c.Request.Context(),
-			&submission_service.AddFilesReq{
-				Url:       val.Url,
-				Type:      val.Type,
-				ArticleId: article.Id,
-			},
-		)
-
-		if err != nil {
-			h.handleResponse(c, http.GRPCError, err.Error())
-			return
-		}
-	}
-
 	h.handleResponse(c, http.OK, resp)
 }
 
@@ -243,6 +193,12 @@ func (h *Handler) UpdateUserDraft(c *gin.Context) {
 // @Param search query string false "search"
 // @Param status query string false "status"
 // @Param group-id query string false "group id"
+// @Param keyword-id query string false "keyword id"
+// @Param cursor query string false "cursor"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
+// @Param step query string false "step"
 // @Success 200 {object} http.Response{data=submission_service.GetArticleListRes} "GetDraftListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -260,7 +216,7 @@ func (h *Handler) GetUserDraftList(c *gin.Context) {
 		return
 	}
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		err = errors.New("invalid user id")
 		h.handleResponse(c, http.InvalidArgument, err.Error())
@@ -270,17 +226,23 @@ func (h *Handler) GetUserDraftList(c *gin.Context) {
 	resp, err := h.services.ArticleService().GetArticleList(
 		c.Request.Context(),
 		&submission_service.GetArticleListReq{
-			Limit:    int32(limit),
-			Offset:   int32(offset),
-			Search:   c.Query("search"),
-			AuthorId: userId,
-			Status:   c.Query("status"),
-			GroupId:  c.Query("group-id"),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.Query("search"),
+			AuthorId:    userId,
+			Status:      c.Query("status"),
+			GroupId:     c.Query("group-id"),
+			KeywordId:   c.Query("keyword-id"),
+			Cursor:      c.Query("cursor"),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+			Step:        c.Query("step"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -308,15 +270,8 @@ func (h *Handler) GetUserDraftByID(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.services.ArticleService().GetArticle(
-		c.Request.Context(),
-		&submission_service.GetArticleReq{
-			Id: articleID,
-		},
-	)
-
-	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+	resp, ok := h.getUserDraft(c, articleID)
+	if !ok {
 		return
 	}
 
@@ -370,6 +325,10 @@ func (h *Handler) DeleteUserDraft(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, articleID); !ok {
+		return
+	}
+
 	_, err := h.services.ArticleService().DeleteArticle(
 		c.Request.Context(),
 		&submission_service.DeleteArticleReq{
@@ -384,11 +343,112 @@ func (h *Handler) DeleteUserDraft(c *gin.Context) {
 	h.handleResponse(c, http.NoContent, "")
 }
 
+// SubmitUserDraftRevision godoc
+// @ID submit_user_draft_revision
+// @Router /user/{user-id}/draft/{draft-id}/revision [POST]
+// @Summary Submit Draft Revision
+// @Description Creates a new revision of the draft sent back for correction. Old revision becomes read-only
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user Id"
+// @Param draft-id path string true "draft-id"
+// @Param revision body models.SubmitDraftRevisionReq true "SubmitDraftRevisionReq"
+// @Success 201 {object} http.Response{data=submission_service.GetArticleRes} "Draft data"
+// @Response 400 {object} http.Response{data=string} "Bad Request"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) SubmitUserDraftRevision(c *gin.Context) {
+	var revision models.SubmitDraftRevisionReq
+
+	draftId := c.Param("draft-id")
+	if !util.IsValidUUID(draftId) {
+		h.handleResponse(c, http.InvalidArgument, "draft id is an invalid uuid")
+		return
+	}
+
+	err := c.ShouldBindJSON(&revision)
+	if err != nil {
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.services.ArticleService().SubmitRevision(
+		c.Request.Context(),
+		&submission_service.SubmitRevisionReq{
+			DraftId:  draftId,
+			AuthorId: h.getUserId(c),
+			Comment:  revision.Comment,
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.Created, resp)
+}
+
+// GetUserDraftRevisions godoc
+// @ID get_user_draft_revisions
+// @Router /user/{user-id}/draft/{draft-id}/revisions [GET]
+// @Summary Get Draft Revisions
+// @Description Get all revisions of the draft, the latest first
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user Id"
+// @Param draft-id path string true "draft-id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} http.Response{data=submission_service.GetArticleListRes} "GetDraftListResponseBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetUserDraftRevisions(c *gin.Context) {
+	draftId := c.Param("draft-id")
+	if !util.IsValidUUID(draftId) {
+		h.handleResponse(c, http.InvalidArgument, "draft id is an invalid uuid")
+		return
+	}
+
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	draft, ok := h.getUserDraft(c, draftId)
+	if !ok {
+		return
+	}
+
+	resp, err := h.services.ArticleService().GetArticleList(
+		c.Request.Context(),
+		&submission_service.GetArticleListReq{
+			Limit:    int32(limit),
+			Offset:   int32(offset),
+			AuthorId: draft.GetAuthorId(),
+			GroupId:  draft.GetGroupId(),
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
 // CreateJournalDraft godoc
 // @ID create_journal_draft
 // @Router /journal/{journal-id}/draft [POST]
 // @Summary Create Draft
-// @Description Create Draft
+// @Description Create Draft, the draft is NEW and is published after it is CONFIRMED
 // @Tags Journal
 // @Accept json
 // @Produce json
@@ -408,8 +468,10 @@ func (h *Handler) CreateJournalDraft(c *gin.Context) {
 		return
 	}
 
+	// the draft goes through the review like the ones sent by the authors
 	article.JournalId = journalId
-	article.Status = config.ARTICLE_STATUS_PUBLISHED
+	article.Status = config.ARTICLE_STATUS_NEW
+	article.RoleType = h.getRoleType(c)
 
 	resp, err := h.services.ArticleService().CreateArticle(
 		c.Request.Context(),
@@ -438,6 +500,12 @@ func (h *Handler) CreateJournalDraft(c *gin.Context) {
 // @Param search query string false "search"
 // @Param status query string false "status"
 // @Param group-id query string false "group_id"
+// @Param keyword-id query string false "keyword id"
+// @Param cursor query string false "cursor"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
+// @Param step query string false "step"
 // @Success 200 {object} http.Response{data=submission_service.GetArticleListRes} "GetDraftListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -465,17 +533,23 @@ func (h *Handler) GetJournalDraftList(c *gin.Context) {
 	resp, err := h.services.ArticleService().GetArticleList(
 		c.Request.Context(),
 		&submission_service.GetArticleListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.Query("search"),
-			JournalId: journalId,
-			Status:    c.Query("status"),
-			GroupId:   c.Query("group-id"),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.Query("search"),
+			JournalId:   journalId,
+			Status:      c.Query("status"),
+			GroupId:     c.Query("group-id"),
+			KeywordId:   c.Query("keyword-id"),
+			Cursor:      c.Query("cursor"),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+			Step:        c.Query("step"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -503,15 +577,8 @@ func (h *Handler) GetJournalDraftByID(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.services.ArticleService().GetArticle(
-		c.Request.Context(),
-		&submission_service.GetArticleReq{
-			Id: articleID,
-		},
-	)
-
-	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+	resp, ok := h.getJournalDraft(c, articleID)
+	if !ok {
 		return
 	}
 
@@ -548,7 +615,7 @@ func (h *Handler) GetJournalDraftByID(c *gin.Context) {
 // @ID update_journal_draft
 // @Router /journal/{journal-id}/draft [PUT]
 // @Summary Update Draft
-// @Description Update Draft
+// @Description Update Draft, CONFIRMED, DENIED and BACK_FOR_CORRECTION send the decision letter to the author. Send letter_subject and letter_text to replace the previewed letter
 // @Tags Journal
 // @Accept json
 // @Produce json
@@ -575,12 +642,41 @@ func (h *Handler) UpdateJournalDraft(c *gin.Context) {
 		return
 	}
 
+	if !util.IsValidUUID(article.Id) {
+		h.handleResponse(c, http.InvalidArgument, "draft id is an invalid uuid")
+		return
+	}
+
+	if _, ok := h.getJournalDraft(c, article.Id); !ok {
+		return
+	}
+
+	// the service validates the status change, the editor check is saved only after it is accepted
+	resp, err := h.services.ArticleService().UpdateArticle(
+		c.Request.Context(),
+		&submission_service.UpdateArticleReq{
+			Status:        article.Status,
+			Id:            article.Id,
+			RoleType:      h.getRoleType(c),
+			ActorId:       h.getUserId(c),
+			Comment:       article.Comment,
+			LetterSubject: article.LetterSubject,
+			LetterText:    article.LetterText,
+		},
+	)
+
+	if err != nil {
+		h.handleGRPCError(c, err)
+		return
+	}
+
 	checker, err := h.services.CheckerService().GetArticleCheckerList(
 		c.Request.Context(),
 		&submission_service.GetArticleCheckerListReq{
-			CheckerId: article.EditorId,
+			CheckerId: h.getUserId(c),
 			Type:      "EDITOR",
 			ArticleId: article.Id,
+			ViewerId:  h.getUserId(c),
 		},
 	)
 	if err != nil {
@@ -605,6 +701,7 @@ func (h *Handler) UpdateJournalDraft(c *gin.Context) {
 				Comment:  article.Comment,
 				Status:   article.CheckerStatus,
 				Comments: fileComments,
+				ActorId:  h.getUserId(c),
 			},
 		)
 		if err != nil {
@@ -615,12 +712,13 @@ func (h *Handler) UpdateJournalDraft(c *gin.Context) {
 		_, err = h.services.CheckerService().CreateArticleChecker(
 			c.Request.Context(),
 			&submission_service.CreateArticleCheckerReq{
-				CheckerId: article.EditorId,
+				CheckerId: h.getUserId(c),
 				ArticleId: article.Id,
 				Comment:   article.Comment,
 				Type:      "EDITOR",
 				Status:    article.CheckerStatus,
 				Comments:  fileComments,
+				EditorId:  h.getUserId(c),
 			},
 		)
 		if err != nil {
@@ -629,14 +727,50 @@ func (h *Handler) UpdateJournalDraft(c *gin.Context) {
 		}
 	}
 
-	resp, err := h.services.ArticleService().UpdateArticle(
+	h.handleResponse(c, http.OK, resp)
+}
+
+// PreviewJournalDraftDecisionLetter godoc
+// @ID preview_journal_draft_decision_letter
+// @Router /journal/{journal-id}/draft/{draft-id}/decision-letter [POST]
+// @Summary Preview Draft Decision Letter
+// @Description Render the decision letter of the status to edit it before the decision is saved
+// @Tags Journal
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "Journal Id"
+// @Param draft-id path string true "draft-id"
+// @Param letter body models.PreviewDecisionLetterReq true "PreviewDecisionLetterReq"
+// @Success 200 {object} http.Response{data=submission_service.DecisionLetter} "DecisionLetter"
+// @Response 400 {object} http.Response{data=string} "Bad Request"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) PreviewJournalDraftDecisionLetter(c *gin.Context) {
+	var letter models.PreviewDecisionLetterReq
+
+	draftId := c.Param("draft-id")
+	if !util.IsValidUUID(draftId) {
+		h.handleResponse(c, http.InvalidArgument, "draft id is an invalid uuid")
+		return
+	}
+
+	if _, ok := h.getJournalDraft(c, draftId); !ok {
+		return
+	}
+
+	err := c.ShouldBindJSON(&letter)
+	if err != nil {
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.services.ArticleService().PreviewDecisionLetter(
 		c.Request.Context(),
-		&submission_service.UpdateArticleReq{
-			Status: article.Status,
-			Id:     article.Id,
+		&submission_service.PreviewDecisionLetterReq{
+			DraftId: draftId,
+			Status:  letter.Status,
+			Comment: letter.Comment,
 		},
 	)
-
 	if err != nil {
 		h.handleResponse(c, http.GRPCError, err.Error())
 		return
@@ -666,6 +800,10 @@ func (h *Handler) DeleteJournalDraft(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleID); !ok {
+		return
+	}
+
 	_, err := h.services.ArticleService().DeleteArticle(
 		c.Request.Context(),
 		&submission_service.DeleteArticleReq{
@@ -694,6 +832,12 @@ func (h *Handler) DeleteJournalDraft(c *gin.Context) {
 // @Param search query string false "search"
 // @Param status query string false "status"
 // @Param group-id query string false "group id"
+// @Param keyword-id query string false "keyword id"
+// @Param cursor query string false "cursor"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
+// @Param step query string false "step"
 // @Success 200 {object} http.Response{data=submission_service.GetArticleListRes} "GetDraftListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -714,17 +858,23 @@ func (h *Handler) GetAdminDraftList(c *gin.Context) {
 	resp, err := h.services.ArticleService().GetArticleList(
 		c.Request.Context(),
 		&submission_service.GetArticleListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.Query("search"),
-			JournalId: c.Query("journal-id"),
-			Status:    c.Query("status"),
-			GroupId:   c.Query("group-id"),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.Query("search"),
+			JournalId:   c.Query("journal-id"),
+			Status:      c.Query("status"),
+			GroupId:     c.Query("group-id"),
+			KeywordId:   c.Query("keyword-id"),
+			Cursor:      c.Query("cursor"),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+			Step:        c.Query("step"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -799,7 +949,7 @@ func (h *Handler) AddDraftFile(c *gin.Context) {
 		filePB submission_service.AddFilesReq
 	)
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		err := errors.New("user id is not valid")
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -813,6 +963,10 @@ func (h *Handler) AddDraftFile(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	err := c.ShouldBindJSON(&file)
 	if err != nil {
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -861,7 +1015,7 @@ func (h *Handler) AddDraftFile(c *gin.Context) {
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) GetDraftFiles(c *gin.Context) {
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		err := errors.New("user id is not valid")
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -875,6 +1029,10 @@ func (h *Handler) GetDraftFiles(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	resp, err := h.services.ArticleService().GetFiles(
 		c.Request.Context(),
 		&submission_service.GetFilesReq{
@@ -905,7 +1063,7 @@ func (h *Handler) GetDraftFiles(c *gin.Context) {
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) DeleteDraftFiles(c *gin.Context) {
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		err := errors.New("user id is not valid")
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -919,6 +1077,10 @@ func (h *Handler) DeleteDraftFiles(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	fileId := c.Param("file-id")
 	if !util.IsValidUUID(fileId) {
 		err := errors.New("file id is not valid")
@@ -929,7 +1091,8 @@ func (h *Handler) DeleteDraftFiles(c *gin.Context) {
 	_, err := h.services.ArticleService().DeleteFiles(
 		c.Request.Context(),
 		&submission_service.DeleteFilesReq{
-			Ids: fileId,
+			Ids:     fileId,
+			DraftId: draftId,
 		},
 	)
 	if err != nil {
@@ -960,7 +1123,7 @@ func (h *Handler) AddDraftCoAuthors(c *gin.Context) {
 		authorPb submission_service.AddCoAuthorReq
 	)
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		err := errors.New("user id is not valid")
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -974,6 +1137,10 @@ func (h *Handler) AddDraftCoAuthors(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	err := c.ShouldBindJSON(&author)
 	if err != nil {
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -1070,7 +1237,7 @@ func (h *Handler) AddDraftCoAuthors(c *gin.Context) {
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) GetDraftCoAuthors(c *gin.Context) {
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		err := errors.New("user id is not valid")
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -1084,6 +1251,10 @@ func (h *Handler) GetDraftCoAuthors(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	resp, err := h.services.ArticleService().GetCoAuthors(
 		c.Request.Context(),
 		&submission_service.GetCoAuthorsReq{
@@ -1114,7 +1285,7 @@ func (h *Handler) GetDraftCoAuthors(c *gin.Context) {
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) DeleteDraftCoAuthors(c *gin.Context) {
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		err := errors.New("user id is not valid")
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -1128,6 +1299,10 @@ func (h *Handler) DeleteDraftCoAuthors(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
 	coauthorId := c.Param("coauthor-id")
 	if !util.IsValidUUID(coauthorId) {
 		err := errors.New("coauthor id is not valid")
@@ -1138,7 +1313,8 @@ func (h *Handler) DeleteDraftCoAuthors(c *gin.Context) {
 	_, err := h.services.ArticleService().DeleteCoAuthor(
 		c.Request.Context(),
 		&submission_service.DeleteCoAuthorReq{
-			Ids: coauthorId,
+			Ids:     coauthorId,
+			DraftId: draftId,
 		},
 	)
 	if err != nil {
@@ -1148,3 +1324,133 @@ func (h *Handler) DeleteDraftCoAuthors(c *gin.Context) {
 
 	h.handleResponse(c, http.NoContent, "")
 }
+
+// GetJournalDraftHistory godoc
+// @ID get_journal_draft_history
+// @Router /journal/{journal-id}/draft/{draft-id}/history [GET]
+// @Summary Get Draft History
+// @Description Get Draft History
+// @Tags Journal
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "journal-id"
+// @Param draft-id path string true "draft-id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} http.Response{data=submission_service.GetDraftHistoryRes} "GetDraftHistoryRes"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetJournalDraftHistory(c *gin.Context) {
+	draftId := c.Param("draft-id")
+	if !util.IsValidUUID(draftId) {
+		h.handleResponse(c, http.InvalidArgument, "draft id is an invalid uuid")
+		return
+	}
+
+	if _, ok := h.getJournalDraft(c, draftId); !ok {
+		return
+	}
+
+	h.getDraftHistory(c, draftId)
+}
+
+// GetUserDraftHistory godoc
+// @ID get_user_draft_history
+// @Router /user/{user-id}/draft/{draft-id}/history [GET]
+// @Summary Get Draft History
+// @Description Get Draft History
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user-id"
+// @Param draft-id path string true "draft-id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} http.Response{data=submission_service.GetDraftHistoryRes} "GetDraftHistoryRes"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetUserDraftHistory(c *gin.Context) {
+	draftId := c.Param("draft-id")
+	if !util.IsValidUUID(draftId) {
+		h.handleResponse(c, http.InvalidArgument, "draft id is an invalid uuid")
+		return
+	}
+
+	if _, ok := h.getUserDraft(c, draftId); !ok {
+		return
+	}
+
+	h.getDraftHistory(c, draftId)
+}
+
+func (h *Handler) getDraftHistory(c *gin.Context, draftId string) {
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	resp, err := h.services.ArticleService().GetDraftHistory(
+		c.Request.Context(),
+		&submission_service.GetDraftHistoryReq{
+			Limit:    int32(limit),
+			Offset:   int32(offset),
+			DraftId:  draftId,
+			ViewerId: h.getUserId(c),
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// getUserDraft loads the draft and responds 403 when the caller isn't its author
+func (h *Handler) getUserDraft(c *gin.Context, draftId string) (*submission_service.GetArticleRes, bool) {
+	draft, err := h.services.ArticleService().GetArticle(
+		c.Request.Context(),
+		&submission_service.GetArticleReq{
+			Id: draftId,
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return nil, false
+	}
+
+	if draft.GetAuthorId() != h.getUserId(c) {
+		h.handleResponse(c, http.Forbidden, "no access to the draft")
+		return nil, false
+	}
+
+	return draft, true
+}
+
+// getJournalDraft loads the draft and responds 403 when it belongs to another journal than the one of the path
+func (h *Handler) getJournalDraft(c *gin.Context, draftId string) (*submission_service.GetArticleRes, bool) {
+	draft, err := h.services.ArticleService().GetArticle(
+		c.Request.Context(),
+		&submission_service.GetArticleReq{
+			Id: draftId,
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return nil, false
+	}
+
+	if draft.GetJournalId() != c.Param("journal-id") {
+		h.handleResponse(c, http.Forbidden, "draft belongs to another journal")
+		return nil, false
+	}
+
+	return draft, true
+}
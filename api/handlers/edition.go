@@ -59,6 +59,9 @@ func (h *Handler) CreateEdition(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=content_service.GetEditionListRes} "GetEditionListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -86,15 +89,18 @@ func (h *Handler) GetEditionList(c *gin.Context) {
 	resp, err := h.services.ContentService().GetEditionList(
 		c.Request.Context(),
 		&content_service.GetEditionListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			JournalId: journalId,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			JournalId:   journalId,
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -108,6 +108,9 @@ func (h *Handler) CreateEditor(c *gin.Context) {
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
 // @Param journal-id query string false "journal-id"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=auth_service.GetUserListRes} "GetEditorListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -129,16 +132,19 @@ func (h *Handler) GetEditorList(c *gin.Context) {
 	resp, err := h.services.UserService().GetUserListByRole(
 		c.Request.Context(),
 		&auth_service.GetUserListByRoleReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			JournalId: journalId,
-			RoleType:  config.EDITOR,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			JournalId:   journalId,
+			RoleType:    config.EDITOR,
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
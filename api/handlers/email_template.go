@@ -4,7 +4,9 @@ import (
 	"editory_submission/api/http"
 	"editory_submission/genproto/notification_service"
 	"editory_submission/pkg/util"
+	"errors"
 	"github.com/gin-gonic/gin"
+	"io"
 )
 
 // CreateAdminEmailTmp godoc
@@ -52,6 +54,11 @@ func (h *Handler) CreateAdminEmailTmp(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
+// @Param type query string false "type"
+// @Param language query string false "en, ru or uz"
 // @Success 200 {object} http.Response{data=notification_service.GetEmailTmpListRes} "GetEmailTmpListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -72,14 +79,19 @@ func (h *Handler) GetAdminEmailTmpList(c *gin.Context) {
 	resp, err := h.services.EmailTmpService().GetEmailTmpList(
 		c.Request.Context(),
 		&notification_service.GetEmailTmpListReq{
-			Limit:  int32(limit),
-			Offset: int32(offset),
-			Search: c.DefaultQuery("search", ""),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+			Type:        c.Query("type"),
+			Language:    c.Query("language"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -155,6 +167,46 @@ func (h *Handler) UpdateAdminEmailTmp(c *gin.Context) {
 	h.handleResponse(c, http.OK, resp)
 }
 
+// PreviewAdminEmailTmp godoc
+// @ID preview_email_template
+// @Router /admin/email/template/{template-id}/preview [POST]
+// @Summary Preview EmailTmp
+// @Description Render the template with sample data, title and text of the body override the stored ones
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param template-id path string true "template-id"
+// @Param email_template body notification_service.PreviewEmailTmpReq false "PreviewEmailTmpRequestBody"
+// @Success 200 {object} http.Response{data=notification_service.PreviewEmailTmpRes} "EmailTmp preview"
+// @Response 400 {object} http.Response{data=string} "Bad Request"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) PreviewAdminEmailTmp(c *gin.Context) {
+	var preview notification_service.PreviewEmailTmpReq
+
+	err := c.ShouldBindJSON(&preview)
+	if err != nil && !errors.Is(err, io.EOF) {
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
+	}
+
+	preview.Id = c.Param("template-id")
+	if !util.IsValidUUID(preview.Id) {
+		h.handleResponse(c, http.InvalidArgument, "email_template id is an invalid uuid")
+		return
+	}
+
+	resp, err := h.services.EmailTmpService().PreviewEmailTmp(
+		c.Request.Context(),
+		&preview,
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
 // DeleteAdminEmailTmp godoc
 // @ID delete_email_template
 // @Router /admin/email/template/{template-id} [DELETE]
@@ -110,6 +110,7 @@ func (h *Handler) GetCityList(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
 // @Success 200 {object} http.Response{data=content_service.GetUniversityListRes} "GetUniversityListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -133,11 +134,12 @@ func (h *Handler) GetUniversityList(c *gin.Context) {
 			Limit:  int32(limit),
 			Offset: int32(offset),
 			Search: c.DefaultQuery("search", ""),
+			Sort:   c.Query("sort"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -155,6 +157,7 @@ func (h *Handler) GetUniversityList(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
 // @Success 200 {object} http.Response{data=content_service.GetSubjectListRes} "GetSubjectListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -178,11 +181,12 @@ func (h *Handler) GetSubjectList(c *gin.Context) {
 			Limit:  int32(limit),
 			Offset: int32(offset),
 			Search: c.DefaultQuery("search", ""),
+			Sort:   c.Query("sort"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -200,6 +204,7 @@ func (h *Handler) GetSubjectList(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
 // @Success 200 {object} http.Response{data=auth_service.GetKeywordListRes} "GetSubjectListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -223,11 +228,12 @@ func (h *Handler) GetKeywordList(c *gin.Context) {
 			Limit:  int32(limit),
 			Offset: int32(offset),
 			Search: c.DefaultQuery("search", ""),
+			Sort:   c.Query("sort"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
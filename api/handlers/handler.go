@@ -4,11 +4,15 @@ import (
 	"editory_submission/api/http"
 	"editory_submission/config"
 	"editory_submission/grpc/client"
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/saidamir98/udevs_pkg/logger"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Handler struct {
@@ -62,10 +66,38 @@ func (h *Handler) handleResponse(c *gin.Context, status http.Status, data interf
 
 func (h *Handler) getOffsetParam(c *gin.Context) (offset int, err error) {
 	offsetStr := c.DefaultQuery("offset", h.cfg.DefaultOffset)
-	return strconv.Atoi(offsetStr)
+	offset, err = strconv.Atoi(offsetStr)
+	if err != nil {
+		return 0, err
+	}
+
+	if offset < 0 {
+		return 0, errors.New("offset must not be negative")
+	}
+
+	return offset, nil
+}
+
+func (h *Handler) getLimitParam(c *gin.Context) (limit int, err error) {
+	limitStr := c.DefaultQuery("limit", h.cfg.DefaultLimit)
+	limit, err = strconv.Atoi(limitStr)
+	if err != nil {
+		return 0, err
+	}
+
+	if limit < 0 || limit > h.cfg.MaxLimit {
+		return 0, fmt.Errorf("limit must be between 0 and %d", h.cfg.MaxLimit)
+	}
+
+	return limit, nil
 }
 
-func (h *Handler) getLimitParam(c *gin.Context) (offset int, err error) {
-	offsetStr := c.DefaultQuery("limit", h.cfg.DefaultLimit)
-	return strconv.Atoi(offsetStr)
+// handleGRPCError responds with 400 to the requests the service rejected, like unknown list filters or not allowed status changes
+func (h *Handler) handleGRPCError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		h.handleResponse(c, http.BadRequest, status.Convert(err).Message())
+	default:
+		h.handleResponse(c, http.GRPCError, err.Error())
+	}
 }
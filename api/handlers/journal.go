@@ -129,12 +129,13 @@ func (h *Handler) GetJournalByID(c *gin.Context) {
 
 // UpdateJournal godoc
 // @ID update_journal
-// @Router /journal [PUT]
+// @Router /journal/{journal-id} [PUT]
 // @Summary Update Journal
 // @Description Update Journal
 // @Tags Journal
 // @Accept json
 // @Produce json
+// @Param journal-id path string true "journal-id"
 // @Param journal body models.JournalUpdateReq true "UpdateJournalRequestBody"
 // @Success 200 {object} http.Response{data=content_service.Journal} "Journal data"
 // @Response 400 {object} http.Response{data=string} "Bad Request"
@@ -146,6 +147,12 @@ func (h *Handler) UpdateJournal(c *gin.Context) {
 		subjects    []*content_service.Subject
 	)
 
+	journalId := c.Param("journal-id")
+	if !util.IsValidUUID(journalId) {
+		h.handleResponse(c, http.InvalidArgument, "journal id is an invalid uuid")
+		return
+	}
+
 	err := c.ShouldBindJSON(&journal)
 	if err != nil {
 		h.handleResponse(c, http.BadRequest, err.Error())
@@ -154,7 +161,7 @@ func (h *Handler) UpdateJournal(c *gin.Context) {
 
 	for _, val := range journal.JournalData {
 		journalData = append(journalData, &content_service.JournalData{
-			JournalId: val.JournalId,
+			JournalId: journalId,
 			Text:      val.Text,
 			Type:      val.Type,
 			ShortText: val.ShortText,
@@ -171,17 +178,17 @@ func (h *Handler) UpdateJournal(c *gin.Context) {
 	resp, err := h.services.ContentService().UpdateJournal(
 		c.Request.Context(),
 		&content_service.Journal{
-			Id:                        journal.Id,
-			Title:                     journal.Title,
-			CoverPhoto:                journal.CoverPhoto,
-			Description:               journal.Description,
-			AcceptanceToPublication:   journal.AcceptanceToPublication,
-			AcceptanceRate:            journal.AcceptanceRate,
-			SubmissionToFinalDecision: journal.SubmissionToFinalDecision,
-			CitationIndicator:         journal.CitationIndicator,
-			ImpactFactor:              journal.ImpactFactor,
-			JournalData:               journalData,
-			Subjects:                  subjects,
+			Id:                      journalId,
+			Title:                   journal.Title,
+			CoverPhoto:              journal.CoverPhoto,
+			Description:             journal.Description,
+			AcceptanceToPublication: journal.AcceptanceToPublication,
+			CitationIndicator:       journal.CitationIndicator,
+			ImpactFactor:            journal.ImpactFactor,
+			JournalData:             journalData,
+			Subjects:                subjects,
+			ReviewDueDays:           journal.ReviewDueDays,
+			ReviewMode:              journal.ReviewMode,
 		},
 	)
 
@@ -334,6 +341,8 @@ func (h *Handler) CreateAdminJournal(c *gin.Context) {
 // @Param date-from query string false "date-from"
 // @Param date-to query string false "date-to"
 // @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=content_service.GetJournalListRes} "GetJournalListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -354,18 +363,20 @@ func (h *Handler) GetAdminJournalList(c *gin.Context) {
 	resp, err := h.services.ContentService().GetJournalList(
 		c.Request.Context(),
 		&content_service.GetList{
-			Limit:    int32(limit),
-			Offset:   int32(offset),
-			Search:   c.DefaultQuery("search", ""),
-			Status:   c.DefaultQuery("status", ""),
-			DateFrom: c.DefaultQuery("date-from", ""),
-			DateTo:   c.DefaultQuery("date-to", ""),
-			Sort:     c.DefaultQuery("sort", ""),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			Status:      c.DefaultQuery("status", ""),
+			DateFrom:    c.DefaultQuery("date-from", ""),
+			DateTo:      c.DefaultQuery("date-to", ""),
+			Sort:        c.DefaultQuery("sort", ""),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -543,6 +554,8 @@ func (h *Handler) DeleteAdminJournal(c *gin.Context) {
 // @Param date-from query string false "date-from"
 // @Param date-to query string false "date-to"
 // @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=content_service.GetJournalListRes} "GetJournalListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -563,18 +576,20 @@ func (h *Handler) GetGeneralJournalList(c *gin.Context) {
 	resp, err := h.services.ContentService().GetJournalList(
 		c.Request.Context(),
 		&content_service.GetList{
-			Limit:    int32(limit),
-			Offset:   int32(offset),
-			Search:   c.DefaultQuery("search", ""),
-			Status:   "ACTIVE",
-			DateFrom: c.DefaultQuery("date-from", ""),
-			DateTo:   c.DefaultQuery("date-to", ""),
-			Sort:     c.DefaultQuery("sort", ""),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			Status:      "ACTIVE",
+			DateFrom:    c.DefaultQuery("date-from", ""),
+			DateTo:      c.DefaultQuery("date-to", ""),
+			Sort:        c.DefaultQuery("sort", ""),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"editory_submission/api/http"
+	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	"editory_submission/pkg/security"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ctxSessionKey   = "session"
+	ctxUserIdKey    = "user_id"
+	ctxRoleIdKey    = "role_id"
+	ctxRoleTypeKey  = "role_type"
+	ctxJournalIdKey = "journal_id"
+)
+
+// AuthMiddleware validates bearer token and puts session identity into the context
+func (h *Handler) AuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, err := security.ExtractToken(c.GetHeader("Authorization"))
+		if err != nil {
+			h.handleResponse(c, http.Unauthorized, err.Error())
+			c.Abort()
+			return
+		}
+
+		session, err := h.services.SessionService().HasAccess(
+			c.Request.Context(),
+			&auth_service.HasAccessReq{
+				AccessToken: token,
+				Path:        c.FullPath(),
+				Method:      c.Request.Method,
+			},
+		)
+		if err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				h.handleResponse(c, http.Forbidden, err.Error())
+			} else {
+				h.handleResponse(c, http.Unauthorized, err.Error())
+			}
+			c.Abort()
+			return
+		}
+
+		c.Set(ctxSessionKey, session)
+		c.Set(ctxUserIdKey, session.GetUserId())
+		c.Set(ctxRoleIdKey, session.GetRoleId())
+		c.Set(ctxRoleTypeKey, session.GetRoleType())
+		c.Set(ctxJournalIdKey, session.GetJournalId())
+
+		c.Next()
+	}
+}
+
+// AdminMiddleware allows only SUPERADMIN role
+func (h *Handler) AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(ctxRoleTypeKey) != config.SUPERADMIN {
+			h.handleResponse(c, http.Forbidden, errors.New("superadmin role is required").Error())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// JournalMiddleware allows only EDITOR role of the journal from the path, every journal route has the journal-id
+func (h *Handler) JournalMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(ctxRoleTypeKey) != config.EDITOR {
+			h.handleResponse(c, http.Forbidden, errors.New("editor role is required").Error())
+			c.Abort()
+			return
+		}
+
+		journalId := c.Param("journal-id")
+		if journalId == "" || journalId != c.GetString(ctxJournalIdKey) {
+			h.handleResponse(c, http.Forbidden, errors.New("no access to the journal").Error())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// UserMiddleware allows only the owner of the user-id from the path
+func (h *Handler) UserMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Param("user-id") != c.GetString(ctxUserIdKey) {
+			h.handleResponse(c, http.Forbidden, errors.New("no access to the user").Error())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func (h *Handler) getUserId(c *gin.Context) string {
+	return c.GetString(ctxUserIdKey)
+}
+
+func (h *Handler) getRoleType(c *gin.Context) string {
+	return c.GetString(ctxRoleTypeKey)
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"editory_submission/api/http"
+	"editory_submission/genproto/auth_service"
+	"editory_submission/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateAdminPermission godoc
+// @ID create_permission
+// @Router /admin/permission [POST]
+// @Summary Create Permission
+// @Description Create Permission
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param permission body auth_service.CreatePermissionReq true "CreatePermissionRequestBody"
+// @Success 201 {object} http.Response{data=auth_service.Permission} "Permission data"
+// @Response 400 {object} http.Response{data=string} "Bad Request"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) CreateAdminPermission(c *gin.Context) {
+	var permission auth_service.CreatePermissionReq
+
+	err := c.ShouldBindJSON(&permission)
+	if err != nil {
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.services.PermissionService().CreatePermission(
+		c.Request.Context(),
+		&permission,
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.Created, resp)
+}
+
+// GetAdminPermissionList godoc
+// @ID get_admin_permission_list
+// @Router /admin/permission [GET]
+// @Summary Get Permission List
+// @Description  Get Permission List
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param search query string false "search"
+// @Param role-type query string false "role-type"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
+// @Success 200 {object} http.Response{data=auth_service.GetPermissionListRes} "GetPermissionListResponseBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetAdminPermissionList(c *gin.Context) {
+
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	resp, err := h.services.PermissionService().GetPermissionList(
+		c.Request.Context(),
+		&auth_service.GetPermissionListReq{
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			RoleType:    c.Query("role-type"),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+		},
+	)
+
+	if err != nil {
+		h.handleGRPCError(c, err)
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// GetAdminPermissionByID godoc
+// @ID get_admin_permission_by_id
+// @Router /admin/permission/{permission-id} [GET]
+// @Summary Get Permission By ID
+// @Description Get Permission By ID
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param permission-id path string true "permission-id"
+// @Success 200 {object} http.Response{data=auth_service.Permission} "PermissionBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetAdminPermissionByID(c *gin.Context) {
+	permissionID := c.Param("permission-id")
+
+	if !util.IsValidUUID(permissionID) {
+		h.handleResponse(c, http.InvalidArgument, "permission id is an invalid uuid")
+		return
+	}
+
+	resp, err := h.services.PermissionService().GetPermission(
+		c.Request.Context(),
+		&auth_service.GetPermissionReq{
+			Id: permissionID,
+		},
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// UpdateAdminPermission godoc
+// @ID update_permission
+// @Router /admin/permission [PUT]
+// @Summary Update Permission
+// @Description Update Permission
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param permission body auth_service.Permission true "PermissionuestBody"
+// @Success 200 {object} http.Response{data=auth_service.Permission} "Permission data"
+// @Response 400 {object} http.Response{data=string} "Bad Request"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) UpdateAdminPermission(c *gin.Context) {
+	var permission auth_service.Permission
+
+	err := c.ShouldBindJSON(&permission)
+	if err != nil {
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.services.PermissionService().UpdatePermission(
+		c.Request.Context(),
+		&permission,
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// DeleteAdminPermission godoc
+// @ID delete_permission
+// @Router /admin/permission/{permission-id} [DELETE]
+// @Summary Delete Permission
+// @Description Get Permission
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param permission-id path string true "permission-id"
+// @Success 204
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) DeleteAdminPermission(c *gin.Context) {
+	permissionID := c.Param("permission-id")
+
+	if !util.IsValidUUID(permissionID) {
+		h.handleResponse(c, http.InvalidArgument, "permission id is an invalid uuid")
+		return
+	}
+
+	_, err := h.services.PermissionService().DeletePermission(
+		c.Request.Context(),
+		&auth_service.DeletePermissionReq{
+			Id: permissionID,
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.NoContent, "")
+}
@@ -1,21 +1,22 @@
 package handlers
 
 import (
+	"bytes"
 	"editory_submission/api/http"
 	"editory_submission/api/models"
 	"editory_submission/config"
-	"editory_submission/genproto/auth_service"
 	pb "editory_submission/genproto/submission_service"
 	"editory_submission/pkg/util"
 	"errors"
 	"github.com/gin-gonic/gin"
+	"html/template"
 )
 
 // CreateArticleReviewer godoc
 // @ID create_article_reviewer
 // @Router /journal/{journal-id}/draft/{draft-id}/reviewer [POST]
 // @Summary Create Article Reviewer
-// @Description Create Article Reviewer
+// @Description Create Article Reviewer. Author, coauthors and their university colleagues are refused unless conflict_override_reason is given
 // @Tags Journal
 // @Accept json
 // @Produce json
@@ -42,63 +43,30 @@ func (h *Handler) CreateArticleReviewer(c *gin.Context) {
 		return
 	}
 
-	err := c.ShouldBindJSON(&reviewer)
-	if err != nil {
-		h.handleResponse(c, http.BadRequest, err.Error())
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
 		return
 	}
 
-	userPb, err := h.services.UserService().GetUser(
-		c.Request.Context(),
-		&auth_service.GetUserReq{
-			Email: reviewer.Email,
-		},
-	)
-	if err != nil {
-		if util.IsErrNoRows(err) {
-			userPb, err = h.services.UserService().CreateUser(
-				c.Request.Context(),
-				&auth_service.User{
-					FirstName: reviewer.FirstName,
-					Email:     reviewer.Email,
-					Password:  config.DEFAULT_PASSWORD,
-				},
-			)
-
-			if err != nil {
-				h.handleResponse(c, http.GRPCError, err.Error())
-				return
-			}
-		} else {
-			h.handleResponse(c, http.GRPCError, err.Error())
-			return
-		}
-	}
-
-	role := auth_service.Role{
-		RoleType:  config.REVIEWER,
-		JournalId: journalId,
-		UserId:    userPb.GetId(),
-	}
-
-	_, err = h.services.RoleService().CreateRole(
-		c.Request.Context(),
-		&role,
-	)
+	err := c.ShouldBindJSON(&reviewer)
 	if err != nil {
-		if !util.IsErrDuplicateKey(err) {
-			h.handleResponse(c, http.GRPCError, err.Error())
-			return
-		}
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
 	}
 
+	// the reviewer is registered by the service together with the assignment
 	resp, err := h.services.CheckerService().CreateArticleChecker(
 		c.Request.Context(),
 		&pb.CreateArticleCheckerReq{
-			CheckerId: userPb.Id,
-			ArticleId: articleId,
-			Status:    "NEW",
-			Type:      config.REVIEWER,
+			ReviewerEmail:          reviewer.Email,
+			ReviewerFirstName:      reviewer.FirstName,
+			ReviewerLastName:       reviewer.LastName,
+			ArticleId:              articleId,
+			Status:                 config.ARTICLE_REVIEWER_STATUS_NEW,
+			Type:                   config.REVIEWER,
+			DueAt:                  reviewer.DueAt,
+			ConflictOverrideReason: reviewer.ConflictOverrideReason,
+			EditorId:               h.getUserId(c),
+			RoleType:               h.getRoleType(c),
 		},
 	)
 
@@ -107,31 +75,6 @@ func (h *Handler) CreateArticleReviewer(c *gin.Context) {
 		return
 	}
 
-	_, err = h.services.ArticleService().UpdateArticle(
-		c.Request.Context(),
-		&pb.UpdateArticleReq{
-			Id:             articleId,
-			Step:           "REVIEWER",
-			ReviewerStatus: "PENDING",
-			EditorStatus:   "PENDING",
-		},
-	)
-	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
-		return
-	}
-
-	_, err = h.services.ArticleService().UpdateArticle(
-		c.Request.Context(),
-		&pb.UpdateArticleReq{
-			Id:   articleId,
-			Step: config.DRAFT_STEP_REVIEWER,
-		})
-	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
-		return
-	}
-
 	h.handleResponse(c, http.Created, resp)
 }
 
@@ -162,6 +105,10 @@ func (h *Handler) DeleteArticleReviewer(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleID); !ok {
+		return
+	}
+
 	reviewerID := c.Param("reviewer-id")
 	if !util.IsValidUUID(reviewerID) {
 		h.handleResponse(c, http.InvalidArgument, "reviewer id is an invalid uuid")
@@ -171,7 +118,8 @@ func (h *Handler) DeleteArticleReviewer(c *gin.Context) {
 	_, err := h.services.CheckerService().DeleteArticleChecker(
 		c.Request.Context(),
 		&pb.DeleteArticleCheckerReq{
-			Id: reviewerID,
+			Id:        reviewerID,
+			ArticleId: articleID,
 		},
 	)
 	if err != nil {
@@ -195,6 +143,10 @@ func (h *Handler) DeleteArticleReviewer(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param overdue query boolean false "overdue"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=pb.GetArticleCheckerListRes} "GetArticleReviewerListRes"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -206,6 +158,10 @@ func (h *Handler) GetArticleReviewList(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
+		return
+	}
+
 	offset, err := h.getOffsetParam(c)
 	if err != nil {
 		h.handleResponse(c, http.InvalidArgument, err.Error())
@@ -221,11 +177,72 @@ func (h *Handler) GetArticleReviewList(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleCheckerList(
 		c.Request.Context(),
 		&pb.GetArticleCheckerListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			ArticleId: articleId,
-			Type:      config.REVIEWER,
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			ArticleId:   articleId,
+			Type:        config.REVIEWER,
+			Overdue:     c.Query("overdue") == "true",
+			ViewerId:    h.getUserId(c),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+		},
+	)
+
+	if err != nil {
+		h.handleGRPCError(c, err)
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// GetArticleReviewerSuggestions godoc
+// @ID get_article_reviewer_suggestions
+// @Router /journal/{journal-id}/draft/{draft-id}/reviewer/suggestions [GET]
+// @Summary Get article reviewer suggestions
+// @Description Get journal reviewers ranked by keyword and subject matches with the draft and their active reviews, coauthors and colleagues of the authors are left out
+// @Tags Journal
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "journal-id"
+// @Param draft-id path string true "draft-id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} http.Response{data=pb.GetReviewerSuggestionsRes} "GetReviewerSuggestionsRes"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetArticleReviewerSuggestions(c *gin.Context) {
+
+	articleId := c.Param("draft-id")
+	if !util.IsValidUUID(articleId) {
+		h.handleResponse(c, http.InvalidArgument, "article id is an invalid uuid")
+		return
+	}
+
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
+		return
+	}
+
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	resp, err := h.services.CheckerService().GetReviewerSuggestions(
+		c.Request.Context(),
+		&pb.GetReviewerSuggestionsReq{
+			Limit:   int32(limit),
+			Offset:  int32(offset),
+			DraftId: articleId,
 		},
 	)
 
@@ -237,6 +254,67 @@ func (h *Handler) GetArticleReviewList(c *gin.Context) {
 	h.handleResponse(c, http.OK, resp)
 }
 
+// GetJournalReviewList godoc
+// @ID get_journal_review_list
+// @Router /journal/{journal-id}/review [GET]
+// @Summary Get journal review List
+// @Description Get reviews of all journal drafts, use overdue filter to find the late ones
+// @Tags Journal
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "journal-id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param status query string false "status"
+// @Param overdue query boolean false "overdue"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
+// @Success 200 {object} http.Response{data=pb.GetArticleCheckerListRes} "GetArticleReviewerListRes"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetJournalReviewList(c *gin.Context) {
+	journalId := c.Param("journal-id")
+	if !util.IsValidUUID(journalId) {
+		h.handleResponse(c, http.InvalidArgument, "journal id is an invalid uuid")
+		return
+	}
+
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	resp, err := h.services.CheckerService().GetArticleCheckerList(
+		c.Request.Context(),
+		&pb.GetArticleCheckerListReq{
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			JournalId:   journalId,
+			Type:        config.REVIEWER,
+			Status:      c.Query("status"),
+			Overdue:     c.Query("overdue") == "true",
+			ViewerId:    h.getUserId(c),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+		},
+	)
+	if err != nil {
+		h.handleGRPCError(c, err)
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
 // GetArticleReviewByID godoc
 // @ID get_article_review_by_id
 // @Router /journal/{journal-id}/draft/{draft-id}/review/{review-id} [GET]
@@ -259,6 +337,10 @@ func (h *Handler) GetArticleReviewByID(c *gin.Context) {
 		return
 	}
 
+	if _, ok := h.getJournalDraft(c, articleId); !ok {
+		return
+	}
+
 	reviewId := c.Param("review-id")
 	if !util.IsValidUUID(reviewId) {
 		h.handleResponse(c, http.InvalidArgument, "review id is an invalid uuid")
@@ -268,7 +350,8 @@ func (h *Handler) GetArticleReviewByID(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleChecker(
 		c.Request.Context(),
 		&pb.GetArticleCheckerReq{
-			Id: reviewId,
+			Id:       reviewId,
+			ViewerId: h.getUserId(c),
 		},
 	)
 
@@ -293,12 +376,15 @@ func (h *Handler) GetArticleReviewByID(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=pb.GetArticleCheckerListRes} "GetArticleReviewerListRes"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) GetUserReviewList(c *gin.Context) {
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
 		return
@@ -319,17 +405,21 @@ func (h *Handler) GetUserReviewList(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleCheckerList(
 		c.Request.Context(),
 		&pb.GetArticleCheckerListReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			CheckerId: userId,
-			Type:      config.REVIEWER,
-			Status:    c.Query("status"),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			CheckerId:   userId,
+			Type:        config.REVIEWER,
+			Status:      c.Query("status"),
+			ViewerId:    userId,
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -351,7 +441,7 @@ func (h *Handler) GetUserReviewList(c *gin.Context) {
 // @Failure 500 {object} http.Response{data=string} "Server Error"
 func (h *Handler) GetUserReviewByID(c *gin.Context) {
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
 		return
@@ -366,7 +456,8 @@ func (h *Handler) GetUserReviewByID(c *gin.Context) {
 	resp, err := h.services.CheckerService().GetArticleChecker(
 		c.Request.Context(),
 		&pb.GetArticleCheckerReq{
-			Id: reviewId,
+			Id:       reviewId,
+			ViewerId: userId,
 		},
 	)
 
@@ -379,6 +470,7 @@ func (h *Handler) GetUserReviewByID(c *gin.Context) {
 		c.Request.Context(),
 		&pb.GetFilesReq{
 			ArticleId: resp.ArticleId,
+			RoleType:  config.REVIEWER,
 		},
 	)
 	if err != nil {
@@ -395,7 +487,7 @@ func (h *Handler) GetUserReviewByID(c *gin.Context) {
 // @ID update_user_review
 // @Router /user/{user-id}/review [PUT]
 // @Summary Update User Review
-// @Description Update User Review
+// @Description Update User Review, APPROVED and REJECTED need answers to all required questions of the journal review form
 // @Tags User
 // @Accept json
 // @Produce json
@@ -408,9 +500,10 @@ func (h *Handler) UpdateUserReview(c *gin.Context) {
 	var (
 		review       models.UpdateUserReviewReq
 		fileComments []*pb.FileComment
+		answers      []*pb.ReviewAnswer
 	)
 
-	userId := c.Param("user-id")
+	userId := h.getUserId(c)
 	if !util.IsValidUUID(userId) {
 		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
 		return
@@ -431,6 +524,13 @@ func (h *Handler) UpdateUserReview(c *gin.Context) {
 		})
 	}
 
+	for _, val := range review.Answers {
+		answers = append(answers, &pb.ReviewAnswer{
+			QuestionId: val.QuestionId,
+			Value:      val.Value,
+		})
+	}
+
 	resp, err := h.services.CheckerService().UpdateArticleChecker(
 		c.Request.Context(),
 		&pb.UpdateArticleCheckerReq{
@@ -438,6 +538,8 @@ func (h *Handler) UpdateUserReview(c *gin.Context) {
 			Status:   review.Status,
 			Comment:  review.Comment,
 			Comments: fileComments,
+			Answers:  answers,
+			ActorId:  userId,
 		},
 	)
 
@@ -448,3 +550,91 @@ func (h *Handler) UpdateUserReview(c *gin.Context) {
 
 	h.handleResponse(c, http.OK, resp)
 }
+
+// reviewerInvitationPage asks the reviewer to confirm the answer of the invitation link, so opening the link changes nothing
+var reviewerInvitationPage = template.Must(template.New("invitation").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Review invitation</title></head>
+<body>
+	<form method="POST">
+		<input type="hidden" name="token" value="{{.}}">
+		<p>Do you have a conflict of interest with the authors of the manuscript? It is required to accept the invitation.</p>
+		<label><input type="radio" name="conflict" value="false"> No</label>
+		<label><input type="radio" name="conflict" value="true"> Yes</label>
+		<p><textarea name="conflict-statement" placeholder="Conflict of interest statement"></textarea></p>
+		<button type="submit">Confirm</button>
+	</form>
+</body>
+</html>`))
+
+// ReviewerInvitationPage godoc
+// @ID reviewer_invitation_page
+// @Router /reviewer/invitation [GET]
+// @Summary Reviewer Invitation Page
+// @Description Renders the confirmation of the signed link from the invitation email, the answer is sent by POST /reviewer/invitation
+// @Tags Reviewer
+// @Produce html
+// @Param token query string true "token"
+// @Success 200 {string} string "confirmation page"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+func (h *Handler) ReviewerInvitationPage(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		h.handleResponse(c, http.InvalidArgument, "token is required")
+		return
+	}
+
+	var page bytes.Buffer
+
+	err := reviewerInvitationPage.Execute(&page, token)
+	if err != nil {
+		h.handleResponse(c, http.InternalServerError, err.Error())
+		return
+	}
+
+	c.Data(http.OK.Code, "text/html; charset=utf-8", page.Bytes())
+}
+
+// RespondReviewerInvitation godoc
+// @ID respond_reviewer_invitation
+// @Router /reviewer/invitation [POST]
+// @Summary Respond Reviewer Invitation
+// @Description Accepts or declines reviewer invitation by the signed token from the invitation email, accepting requires the conflict of interest declaration
+// @Tags Reviewer
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param token formData string true "token"
+// @Param conflict formData boolean false "reviewer has a conflict of interest, required to accept"
+// @Param conflict-statement formData string false "conflict of interest statement, required when conflict is true"
+// @Success 200 {object} http.Response{data=pb.ReviewerInvitation} "ReviewerInvitation"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) RespondReviewerInvitation(c *gin.Context) {
+	token := c.PostForm("token")
+	if token == "" {
+		h.handleResponse(c, http.InvalidArgument, "token is required")
+		return
+	}
+
+	conflict := c.PostForm("conflict")
+	if conflict != "" && conflict != "true" && conflict != "false" {
+		h.handleResponse(c, http.InvalidArgument, "conflict must be true or false")
+		return
+	}
+
+	resp, err := h.services.CheckerService().RespondReviewerInvitation(
+		c.Request.Context(),
+		&pb.RespondReviewerInvitationReq{
+			Token:             token,
+			ConflictDeclared:  conflict != "",
+			HasConflict:       conflict == "true",
+			ConflictStatement: c.PostForm("conflict-statement"),
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"editory_submission/api/http"
+	"editory_submission/genproto/content_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateReviewForm godoc
+// @ID create_review_form
+// @Router /journal/{journal-id}/review-form [POST]
+// @Summary Create Review Form
+// @Description Create review form with questions, active form replaces the current active form of the journal
+// @Tags ReviewForm
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "Journal Id"
+// @Param review-form body content_service.CreateReviewFormReq true "CreateReviewFormRequestBody"
+// @Success 201 {object} http.Response{data=content_service.ReviewForm} "ReviewForm data"
+// @Response 400 {object} http.Response{data=string} "Bad Request"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) CreateReviewForm(c *gin.Context) {
+	var reviewForm content_service.CreateReviewFormReq
+
+	journalId := c.Param("journal-id")
+	if !util.IsValidUUID(journalId) {
+		h.handleResponse(c, http.InvalidArgument, "journal id is an invalid uuid")
+		return
+	}
+
+	err := c.ShouldBindJSON(&reviewForm)
+	if err != nil {
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
+	}
+
+	reviewForm.JournalId = journalId
+
+	resp, err := h.services.ReviewFormService().CreateReviewForm(
+		c.Request.Context(),
+		&reviewForm,
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.Created, resp)
+}
+
+// GetReviewFormList godoc
+// @ID get_review_form_list
+// @Router /journal/{journal-id}/review-form [GET]
+// @Summary Get Review Form List
+// @Description Get Review Form List
+// @Tags ReviewForm
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "Journal Id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param search query string false "search"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
+// @Success 200 {object} http.Response{data=content_service.GetReviewFormListRes} "GetReviewFormListResponseBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetReviewFormList(c *gin.Context) {
+
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	journalId := c.Param("journal-id")
+	if !util.IsValidUUID(journalId) {
+		h.handleResponse(c, http.InvalidArgument, "journal id is an invalid uuid")
+		return
+	}
+
+	resp, err := h.services.ReviewFormService().GetReviewFormList(
+		c.Request.Context(),
+		&content_service.GetReviewFormListReq{
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			JournalId:   journalId,
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
+		},
+	)
+
+	if err != nil {
+		h.handleGRPCError(c, err)
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// GetReviewFormByID godoc
+// @ID get_review_form_by_id
+// @Router /journal/{journal-id}/review-form/{review-form-id} [GET]
+// @Summary Get Review Form By ID
+// @Description Get Review Form By ID
+// @Tags ReviewForm
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "Journal Id"
+// @Param review-form-id path string true "review-form-id"
+// @Success 200 {object} http.Response{data=content_service.ReviewForm} "ReviewFormBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetReviewFormByID(c *gin.Context) {
+	journalId := c.Param("journal-id")
+	if !util.IsValidUUID(journalId) {
+		h.handleResponse(c, http.InvalidArgument, "journal id is an invalid uuid")
+		return
+	}
+
+	reviewFormId := c.Param("review-form-id")
+	if !util.IsValidUUID(reviewFormId) {
+		h.handleResponse(c, http.InvalidArgument, "review form id is an invalid uuid")
+		return
+	}
+
+	resp, err := h.services.ReviewFormService().GetReviewForm(
+		c.Request.Context(),
+		&content_service.GetReviewFormReq{
+			Id:        reviewFormId,
+			JournalId: journalId,
+		},
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// UpdateReviewForm godoc
+// @ID update_review_form
+// @Router /journal/{journal-id}/review-form [PUT]
+// @Summary Update Review Form
+// @Description Update review form, sent questions replace the current ones. Answered questions can't be removed
+// @Tags ReviewForm
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "Journal Id"
+// @Param review-form body content_service.UpdateReviewFormReq true "UpdateReviewFormRequestBody"
+// @Success 200 {object} http.Response{data=content_service.ReviewForm} "ReviewForm data"
+// @Response 400 {object} http.Response{data=string} "Bad Request"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) UpdateReviewForm(c *gin.Context) {
+	var reviewForm content_service.UpdateReviewFormReq
+
+	journalId := c.Param("journal-id")
+	if !util.IsValidUUID(journalId) {
+		h.handleResponse(c, http.InvalidArgument, "journal id is an invalid uuid")
+		return
+	}
+
+	err := c.ShouldBindJSON(&reviewForm)
+	if err != nil {
+		h.handleResponse(c, http.BadRequest, err.Error())
+		return
+	}
+
+	reviewForm.JournalId = journalId
+
+	resp, err := h.services.ReviewFormService().UpdateReviewForm(
+		c.Request.Context(),
+		&reviewForm,
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// DeleteReviewForm godoc
+// @ID delete_review_form
+// @Router /journal/{journal-id}/review-form/{review-form-id} [DELETE]
+// @Summary Delete Review Form
+// @Description Delete Review Form
+// @Tags ReviewForm
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "Journal Id"
+// @Param review-form-id path string true "review-form-id"
+// @Success 204
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) DeleteReviewForm(c *gin.Context) {
+	journalId := c.Param("journal-id")
+	if !util.IsValidUUID(journalId) {
+		h.handleResponse(c, http.InvalidArgument, "journal id is an invalid uuid")
+		return
+	}
+
+	reviewFormId := c.Param("review-form-id")
+	if !util.IsValidUUID(reviewFormId) {
+		h.handleResponse(c, http.InvalidArgument, "review form id is an invalid uuid")
+		return
+	}
+
+	_, err := h.services.ReviewFormService().DeleteReviewForm(
+		c.Request.Context(),
+		&content_service.DeleteReviewFormReq{
+			Id:        reviewFormId,
+			JournalId: journalId,
+		},
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.NoContent, "")
+}
+
+// GetUserReviewForm godoc
+// @ID get_user_review_form
+// @Router /user/{user-id}/review/{review-id}/form [GET]
+// @Summary Get user review form
+// @Description Get the active review form of the reviewed draft journal
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user-id"
+// @Param review-id path string true "review-id"
+// @Success 200 {object} http.Response{data=content_service.ReviewForm} "ReviewForm"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetUserReviewForm(c *gin.Context) {
+	userId := h.getUserId(c)
+	if !util.IsValidUUID(userId) {
+		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
+		return
+	}
+
+	reviewId := c.Param("review-id")
+	if !util.IsValidUUID(reviewId) {
+		h.handleResponse(c, http.InvalidArgument, "review id is an invalid uuid")
+		return
+	}
+
+	review, err := h.services.CheckerService().GetArticleChecker(
+		c.Request.Context(),
+		&pb.GetArticleCheckerReq{
+			Id:       reviewId,
+			ViewerId: userId,
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	if review.GetCheckerId() != userId {
+		h.handleResponse(c, http.Forbidden, "review belongs to another user")
+		return
+	}
+
+	resp, err := h.services.ReviewFormService().GetReviewForm(
+		c.Request.Context(),
+		&content_service.GetReviewFormReq{
+			JournalId: review.GetArticleIdData().GetJournalId(),
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"editory_submission/api/http"
+	"editory_submission/genproto/content_service"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Search godoc
+// @ID search
+// @Router /search [GET]
+// @Summary Search
+// @Description Search published articles and journals. Results are ranked and contain highlighted snippets
+// @Tags General
+// @Accept json
+// @Produce json
+// @Param q query string true "q"
+// @Param type query string false "type" Enums(ARTICLE, JOURNAL)
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} http.Response{data=content_service.SearchRes} "SearchResponseBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) Search(c *gin.Context) {
+	h.search(c, "", "")
+}
+
+// SearchUser godoc
+// @ID search_user
+// @Router /user/{user-id}/search [GET]
+// @Summary Search User
+// @Description Search published articles, journals and the drafts of the user
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user-id"
+// @Param q query string true "q"
+// @Param type query string false "type" Enums(ARTICLE, JOURNAL, DRAFT)
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} http.Response{data=content_service.SearchRes} "SearchResponseBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) SearchUser(c *gin.Context) {
+	h.search(c, h.getUserId(c), "")
+}
+
+// SearchJournal godoc
+// @ID search_journal
+// @Router /journal/{journal-id}/search [GET]
+// @Summary Search Journal
+// @Description Search the articles and drafts of the journal
+// @Tags Journal
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "journal-id"
+// @Param q query string true "q"
+// @Param type query string false "type" Enums(ARTICLE, JOURNAL, DRAFT)
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} http.Response{data=content_service.SearchRes} "SearchResponseBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) SearchJournal(c *gin.Context) {
+	h.search(c, "", c.Param("journal-id"))
+}
+
+func (h *Handler) search(c *gin.Context, authorId, journalId string) {
+	q := strings.TrimSpace(c.Query("q"))
+	if q == "" {
+		h.handleResponse(c, http.BadRequest, "q is required")
+		return
+	}
+
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	resp, err := h.services.SearchService().Search(
+		c.Request.Context(),
+		&content_service.SearchReq{
+			Q:         q,
+			Type:      c.Query("type"),
+			Limit:     int32(limit),
+			Offset:    int32(offset),
+			AuthorId:  authorId,
+			JournalId: journalId,
+		},
+	)
+
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"editory_submission/api/http"
+	"editory_submission/genproto/content_service"
+	"editory_submission/genproto/submission_service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetJournalStats godoc
+// @ID get_journal_stats
+// @Router /journal/{journal-id}/stats [GET]
+// @Summary Get Journal Stats
+// @Description Get the submission dashboard of the journal: drafts by status and step, decision times, acceptance rate and reviewer turnaround
+// @Tags Journal
+// @Accept json
+// @Produce json
+// @Param journal-id path string true "journal-id"
+// @Success 200 {object} http.Response{data=submission_service.JournalStats} "JournalStatsBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetJournalStats(c *gin.Context) {
+	resp, err := h.services.ArticleService().GetJournalStats(
+		c.Request.Context(),
+		&submission_service.GetJournalStatsReq{
+			JournalId: c.Param("journal-id"),
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// GetAdminStats godoc
+// @ID get_admin_stats
+// @Router /admin/stats [GET]
+// @Summary Get Platform Stats
+// @Description Get the platform metrics of the range: registrations, verified accounts, drafts per journal, published articles per edition and notification delivery
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param date-from query string false "date-from"
+// @Param date-to query string false "date-to"
+// @Param granularity query string false "DAY, WEEK or MONTH"
+// @Success 200 {object} http.Response{data=content_service.PlatformStats} "PlatformStatsBody"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetAdminStats(c *gin.Context) {
+	resp, err := h.services.AnalyticsService().GetPlatformStats(
+		c.Request.Context(),
+		&content_service.GetPlatformStatsReq{
+			DateFrom:    c.Query("date-from"),
+			DateTo:      c.Query("date-to"),
+			Granularity: c.Query("granularity"),
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
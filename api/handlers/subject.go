@@ -52,6 +52,7 @@ func (h *Handler) CreateAdminSubject(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
 // @Success 200 {object} http.Response{data=content_service.GetSubjectListRes} "GetSubjectListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -75,11 +76,12 @@ func (h *Handler) GetAdminSubjectList(c *gin.Context) {
 			Limit:  int32(limit),
 			Offset: int32(offset),
 			Search: c.DefaultQuery("search", ""),
+			Sort:   c.Query("sort"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -54,6 +54,7 @@ func (h *Handler) CreateAdminUniversity(c *gin.Context) {
 // @Param offset query integer false "offset"
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
+// @Param sort query string false "sort"
 // @Success 200 {object} http.Response{data=content_service.GetUniversityListRes} "GetUniversityListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -77,11 +78,12 @@ func (h *Handler) GetAdminUniversityList(c *gin.Context) {
 			Limit:  int32(limit),
 			Offset: int32(offset),
 			Search: c.DefaultQuery("search", ""),
+			Sort:   c.Query("sort"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
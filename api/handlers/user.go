@@ -117,6 +117,9 @@ func (h *Handler) CreateJournalUser(c *gin.Context) {
 // @Param limit query integer false "limit"
 // @Param search query string false "search"
 // @Param role-type query string false "role-type"
+// @Param sort query string false "sort"
+// @Param created-from query string false "created-from"
+// @Param created-to query string false "created-to"
 // @Success 200 {object} http.Response{data=auth_service.GetUserListRes} "GetUserListResponseBody"
 // @Response 400 {object} http.Response{data=string} "Invalid Argument"
 // @Failure 500 {object} http.Response{data=string} "Server Error"
@@ -144,16 +147,19 @@ func (h *Handler) GetJournalUserList(c *gin.Context) {
 	resp, err := h.services.UserService().GetUserListByRole(
 		c.Request.Context(),
 		&auth_service.GetUserListByRoleReq{
-			Limit:     int32(limit),
-			Offset:    int32(offset),
-			Search:    c.DefaultQuery("search", ""),
-			JournalId: journalId,
-			RoleType:  c.Query("role-type"),
+			Limit:       int32(limit),
+			Offset:      int32(offset),
+			Search:      c.DefaultQuery("search", ""),
+			JournalId:   journalId,
+			RoleType:    c.Query("role-type"),
+			Sort:        c.Query("sort"),
+			CreatedFrom: c.Query("created-from"),
+			CreatedTo:   c.Query("created-to"),
 		},
 	)
 
 	if err != nil {
-		h.handleResponse(c, http.GRPCError, err.Error())
+		h.handleGRPCError(c, err)
 		return
 	}
 
@@ -411,10 +417,11 @@ func (h *Handler) GetProfileByID(c *gin.Context) {
 	}
 
 	user := models.GetAdminUserRes{
-		Gender:    resp.GetGender(),
-		FirstName: resp.GetFirstName(),
-		LastName:  resp.GetLastName(),
-		Email:     resp.GetEmail(),
+		Gender:            resp.GetGender(),
+		FirstName:         resp.GetFirstName(),
+		LastName:          resp.GetLastName(),
+		Email:             resp.GetEmail(),
+		PreferredLanguage: resp.GetPreferredLanguage(),
 	}
 
 	h.handleResponse(c, http.OK, user)
@@ -444,10 +451,11 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 	resp, err := h.services.UserService().UpdateUser(
 		c.Request.Context(),
 		&auth_service.UpdateUserReq{
-			Gender:    user.Gender,
-			FirstName: user.FirstName,
-			LastName:  user.LastName,
-			Email:     user.LastName,
+			Gender:            user.Gender,
+			FirstName:         user.FirstName,
+			LastName:          user.LastName,
+			Email:             user.LastName,
+			PreferredLanguage: user.PreferredLanguage,
 		},
 	)
 
@@ -457,11 +465,12 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 	}
 
 	res := models.UpdateAdminUserRes{
-		Gender:    resp.GetGender(),
-		FirstName: resp.GetFirstName(),
-		LastName:  resp.GetLastName(),
-		Email:     resp.GetEmail(),
-		Password:  resp.GetPassword(),
+		Gender:            resp.GetGender(),
+		FirstName:         resp.GetFirstName(),
+		LastName:          resp.GetLastName(),
+		Email:             resp.GetEmail(),
+		Password:          resp.GetPassword(),
+		PreferredLanguage: resp.GetPreferredLanguage(),
 	}
 
 	h.handleResponse(c, http.OK, res)
@@ -622,19 +631,20 @@ func (h *Handler) RegisterDetail(c *gin.Context) {
 	}
 
 	userPb = auth_service.UpdateUserReq{
-		Id:         user.Id,
-		Username:   user.Username,
-		FirstName:  user.FirstName,
-		LastName:   user.LastName,
-		Phone:      user.Phone,
-		ExtraPhone: user.ExtraPhone,
-		CountryId:  user.CountryId,
-		CityId:     user.CityId,
-		ProfSphere: user.ProfSphere,
-		Degree:     user.Degree,
-		Address:    user.Address,
-		PostCode:   user.PostCode,
-		Gender:     user.Gender,
+		Id:                user.Id,
+		Username:          user.Username,
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		Phone:             user.Phone,
+		ExtraPhone:        user.ExtraPhone,
+		CountryId:         user.CountryId,
+		CityId:            user.CityId,
+		ProfSphere:        user.ProfSphere,
+		Degree:            user.Degree,
+		Address:           user.Address,
+		PostCode:          user.PostCode,
+		Gender:            user.Gender,
+		PreferredLanguage: user.PreferredLanguage,
 	}
 
 	resp, err := h.services.UserService().UpdateUser(
@@ -662,20 +672,21 @@ func (h *Handler) RegisterDetail(c *gin.Context) {
 	}
 
 	res = models.RegisterDetailRes{
-		Id:         resp.Id,
-		Username:   resp.Username,
-		FirstName:  resp.FirstName,
-		LastName:   resp.LastName,
-		Phone:      resp.Phone,
-		ExtraPhone: resp.ExtraPhone,
-		CountryId:  resp.CountryId,
-		CityId:     resp.CityId,
-		ProfSphere: resp.ProfSphere,
-		Degree:     resp.Degree,
-		Address:    resp.Address,
-		PostCode:   resp.PostCode,
-		Gender:     resp.Gender,
-		IsReviewer: user.IsReviewer,
+		Id:                resp.Id,
+		Username:          resp.Username,
+		FirstName:         resp.FirstName,
+		LastName:          resp.LastName,
+		Phone:             resp.Phone,
+		ExtraPhone:        resp.ExtraPhone,
+		CountryId:         resp.CountryId,
+		CityId:            resp.CityId,
+		ProfSphere:        resp.ProfSphere,
+		Degree:            resp.Degree,
+		Address:           resp.Address,
+		PostCode:          resp.PostCode,
+		Gender:            resp.Gender,
+		IsReviewer:        user.IsReviewer,
+		PreferredLanguage: resp.PreferredLanguage,
 	}
 
 	h.handleResponse(c, http.OK, res)
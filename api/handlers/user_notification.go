@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"editory_submission/api/http"
+	pb "editory_submission/genproto/notification_service"
+	"editory_submission/pkg/util"
+	"github.com/gin-gonic/gin"
+)
+
+// GetUserNotificationList godoc
+// @ID get_user_notification_list
+// @Router /user/{user-id}/notifications [GET]
+// @Summary Get User Notification List
+// @Description Get the in-app notifications of the user, newest first. unread_count counts all unread notifications of the user
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user-id"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Param unread query boolean false "unread"
+// @Param type query string false "type"
+// @Success 200 {object} http.Response{data=pb.GetUserNotificationListRes} "GetUserNotificationListRes"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) GetUserNotificationList(c *gin.Context) {
+	userId := h.getUserId(c)
+	if !util.IsValidUUID(userId) {
+		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
+		return
+	}
+
+	offset, err := h.getOffsetParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	limit, err := h.getLimitParam(c)
+	if err != nil {
+		h.handleResponse(c, http.InvalidArgument, err.Error())
+		return
+	}
+
+	resp, err := h.services.UserNotificationService().GetUserNotificationList(
+		c.Request.Context(),
+		&pb.GetUserNotificationListReq{
+			UserId: userId,
+			Limit:  int32(limit),
+			Offset: int32(offset),
+			Unread: c.Query("unread") == "true",
+			Type:   c.Query("type"),
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
+
+// MarkUserNotificationRead godoc
+// @ID mark_user_notification_read
+// @Router /user/{user-id}/notifications/{notification-id}/read [PUT]
+// @Summary Mark User Notification Read
+// @Description Mark User Notification Read, the first read time is kept
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user-id"
+// @Param notification-id path string true "notification-id"
+// @Success 204
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) MarkUserNotificationRead(c *gin.Context) {
+	userId := h.getUserId(c)
+	if !util.IsValidUUID(userId) {
+		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
+		return
+	}
+
+	notificationId := c.Param("notification-id")
+	if !util.IsValidUUID(notificationId) {
+		h.handleResponse(c, http.InvalidArgument, "notification id is an invalid uuid")
+		return
+	}
+
+	_, err := h.services.UserNotificationService().MarkUserNotificationRead(
+		c.Request.Context(),
+		&pb.MarkUserNotificationReadReq{
+			Id:     notificationId,
+			UserId: userId,
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.NoContent, "")
+}
+
+// MarkAllUserNotificationsRead godoc
+// @ID mark_all_user_notifications_read
+// @Router /user/{user-id}/notifications/read [PUT]
+// @Summary Mark All User Notifications Read
+// @Description Mark all unread notifications of the user read
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param user-id path string true "user-id"
+// @Success 200 {object} http.Response{data=pb.MarkAllUserNotificationsReadRes} "MarkAllUserNotificationsReadRes"
+// @Response 400 {object} http.Response{data=string} "Invalid Argument"
+// @Failure 500 {object} http.Response{data=string} "Server Error"
+func (h *Handler) MarkAllUserNotificationsRead(c *gin.Context) {
+	userId := h.getUserId(c)
+	if !util.IsValidUUID(userId) {
+		h.handleResponse(c, http.InvalidArgument, "user id is an invalid uuid")
+		return
+	}
+
+	resp, err := h.services.UserNotificationService().MarkAllUserNotificationsRead(
+		c.Request.Context(),
+		&pb.MarkAllUserNotificationsReadReq{
+			UserId: userId,
+		},
+	)
+	if err != nil {
+		h.handleResponse(c, http.GRPCError, err.Error())
+		return
+	}
+
+	h.handleResponse(c, http.OK, resp)
+}
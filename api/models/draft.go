@@ -11,10 +11,15 @@ type CreateUserDraftReq struct {
 	Funding      string            `json:"funding,omitempty"`
 	Status       string            `json:"status,omitempty"`
 	DraftStep    string            `json:"draft_step,omitempty"`
+	Keywords     []string          `json:"keywords,omitempty"`
 	Files        []*AddFileReq     `json:"files,omitempty"`
 	Coauthors    []*AddCoAuthorReq `json:"coauthors"`
 }
 
+type SubmitDraftRevisionReq struct {
+	Comment string `json:"comment,omitempty"`
+}
+
 type AddFileReq struct {
 	Url  string `json:"url,omitempty"`
 	Type string `json:"type,omitempty"`
@@ -29,11 +34,17 @@ type File struct {
 
 type UpdateJournalDraftReq struct {
 	Status        string         `json:"status,omitempty"`
-	EditorId      string         `json:"editor_id,omitempty"`
 	Id            string         `json:"id,omitempty"`
 	CheckerStatus string         `json:"checker_status,omitempty"`
 	Comment       string         `json:"comment"`
 	FileComments  []*FileComment `json:"file_comment,omitempty"`
+	LetterSubject string         `json:"letter_subject,omitempty"`
+	LetterText    string         `json:"letter_text,omitempty"`
+}
+
+type PreviewDecisionLetterReq struct {
+	Status  string `json:"status"`
+	Comment string `json:"comment"`
 }
 
 type FileComment struct {
@@ -44,17 +55,19 @@ type FileComment struct {
 }
 
 type UpdateUserDraftReq struct {
-	Id           string        `json:"id"`
-	JournalId    string        `json:"journal_id,omitempty"`
-	Type         string        `json:"type,omitempty"`
-	Title        string        `json:"title,omitempty"`
-	Description  string        `json:"description,omitempty"`
-	Conflict     bool          `json:"conflict,omitempty"`
-	Availability string        `json:"availability,omitempty"`
-	Funding      string        `json:"funding,omitempty"`
-	Status       string        `json:"status,omitempty"`
-	DraftStep    string        `json:"draft_step,omitempty"`
-	Files        []*AddFileReq `json:"files,omitempty"`
+	Id           string            `json:"id"`
+	JournalId    string            `json:"journal_id,omitempty"`
+	Type         string            `json:"type,omitempty"`
+	Title        string            `json:"title,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	Conflict     bool              `json:"conflict,omitempty"`
+	Availability string            `json:"availability,omitempty"`
+	Funding      string            `json:"funding,omitempty"`
+	Status       string            `json:"status,omitempty"`
+	DraftStep    string            `json:"draft_step,omitempty"`
+	Keywords     []string          `json:"keywords,omitempty"`
+	Files        []*AddFileReq     `json:"files,omitempty"`
+	Coauthors    []*AddCoAuthorReq `json:"coauthors,omitempty"`
 }
 
 type AddCoAuthorReq struct {
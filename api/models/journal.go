@@ -36,17 +36,16 @@ type AdminJournalUpdateRes struct {
 }
 
 type JournalUpdateReq struct {
-	Id                        string        `json:"id,omitempty"`
-	CoverPhoto                string        `json:"cover_photo,omitempty"`
-	Title                     string        `json:"title,omitempty"`
-	Description               string        `json:"description,omitempty"`
-	JournalData               []JournalData `json:"journal_data,omitempty"`
-	AcceptanceRate            string        `json:"acceptance_rate,omitempty"`
-	SubmissionToFinalDecision string        `json:"submission_to_final_decision,omitempty"`
-	AcceptanceToPublication   string        `json:"acceptance_to_publication,omitempty"`
-	CitationIndicator         string        `json:"citation_indicator,omitempty"`
-	ImpactFactor              string        `json:"impact_factor,omitempty"`
-	Subjects                  []Subject     `json:"subjects,omitempty"`
+	CoverPhoto              string        `json:"cover_photo,omitempty"`
+	Title                   string        `json:"title,omitempty"`
+	Description             string        `json:"description,omitempty"`
+	JournalData             []JournalData `json:"journal_data,omitempty"`
+	AcceptanceToPublication string        `json:"acceptance_to_publication,omitempty"`
+	CitationIndicator       string        `json:"citation_indicator,omitempty"`
+	ImpactFactor            string        `json:"impact_factor,omitempty"`
+	Subjects                []Subject     `json:"subjects,omitempty"`
+	ReviewDueDays           int32         `json:"review_due_days,omitempty"`
+	ReviewMode              string        `json:"review_mode,omitempty"`
 }
 
 type JournalData struct {
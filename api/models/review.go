@@ -1,9 +1,11 @@
 package models
 
 type CreateArticleReviewerReq struct {
-	FirstName string `json:"first_name,omitempty"`
-	LastName  string `json:"last_name,omitempty"`
-	Email     string `json:"email,omitempty"`
+	FirstName              string `json:"first_name,omitempty"`
+	LastName               string `json:"last_name,omitempty"`
+	Email                  string `json:"email,omitempty"`
+	DueAt                  string `json:"due_at,omitempty"`
+	ConflictOverrideReason string `json:"conflict_override_reason,omitempty"`
 }
 
 type UpdateUserReviewReq struct {
@@ -11,4 +13,10 @@ type UpdateUserReviewReq struct {
 	Status       string
 	Comment      string
 	FileComments []*FileComment
+	Answers      []*ReviewAnswer
+}
+
+type ReviewAnswer struct {
+	QuestionId string `json:"question_id"`
+	Value      string `json:"value"`
 }
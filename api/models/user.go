@@ -11,28 +11,31 @@ type EmailVerificationReq struct {
 }
 
 type UpdateAdminUserReq struct {
-	Gender          string `json:"gender,omitempty"`
-	FirstName       string `json:"first_name,omitempty"`
-	LastName        string `json:"last_name,omitempty"`
-	Email           string `json:"email,omitempty"`
-	CurrentPassword string `json:"current_password,omitempty"`
-	NewPassword     string `json:"new_password,omitempty"`
-	ConfirmPassword string `json:"confirm_password,omitempty"`
+	Gender            string `json:"gender,omitempty"`
+	FirstName         string `json:"first_name,omitempty"`
+	LastName          string `json:"last_name,omitempty"`
+	Email             string `json:"email,omitempty"`
+	CurrentPassword   string `json:"current_password,omitempty"`
+	NewPassword       string `json:"new_password,omitempty"`
+	ConfirmPassword   string `json:"confirm_password,omitempty"`
+	PreferredLanguage string `json:"preferred_language,omitempty"`
 }
 
 type UpdateAdminUserRes struct {
-	Gender    string `json:"gender,omitempty"`
-	FirstName string `json:"first_name,omitempty"`
-	LastName  string `json:"last_name,omitempty"`
-	Email     string `json:"email,omitempty"`
-	Password  string `json:"password,omitempty"`
+	Gender            string `json:"gender,omitempty"`
+	FirstName         string `json:"first_name,omitempty"`
+	LastName          string `json:"last_name,omitempty"`
+	Email             string `json:"email,omitempty"`
+	Password          string `json:"password,omitempty"`
+	PreferredLanguage string `json:"preferred_language,omitempty"`
 }
 
 type GetAdminUserRes struct {
-	Gender    string `json:"gender,omitempty"`
-	FirstName string `json:"first_name,omitempty"`
-	LastName  string `json:"last_name,omitempty"`
-	Email     string `json:"email,omitempty"`
+	Gender            string `json:"gender,omitempty"`
+	FirstName         string `json:"first_name,omitempty"`
+	LastName          string `json:"last_name,omitempty"`
+	Email             string `json:"email,omitempty"`
+	PreferredLanguage string `json:"preferred_language,omitempty"`
 }
 
 type EmailVerificationRes struct {
@@ -54,37 +57,39 @@ type RegistrationEmailRes struct {
 }
 
 type RegisterDetailReq struct {
-	Id         string `json:"id,omitempty"`
-	Username   string `json:"username,omitempty"`
-	FirstName  string `json:"first_name,omitempty"`
-	LastName   string `json:"last_name,omitempty"`
-	Phone      string `json:"phone,omitempty"`
-	ExtraPhone string `json:"extra_phone,omitempty"`
-	CountryId  string `json:"country_id,omitempty"`
-	CityId     string `json:"city_id,omitempty"`
-	ProfSphere string `json:"prof_sphere,omitempty"`
-	Degree     string `json:"degree,omitempty"`
-	Address    string `json:"address,omitempty"`
-	PostCode   string `json:"post_code,omitempty"`
-	Gender     string `json:"gender,omitempty"`
-	IsReviewer bool   `json:"is_reviewer,omitempty"`
+	Id                string `json:"id,omitempty"`
+	Username          string `json:"username,omitempty"`
+	FirstName         string `json:"first_name,omitempty"`
+	LastName          string `json:"last_name,omitempty"`
+	Phone             string `json:"phone,omitempty"`
+	ExtraPhone        string `json:"extra_phone,omitempty"`
+	CountryId         string `json:"country_id,omitempty"`
+	CityId            string `json:"city_id,omitempty"`
+	ProfSphere        string `json:"prof_sphere,omitempty"`
+	Degree            string `json:"degree,omitempty"`
+	Address           string `json:"address,omitempty"`
+	PostCode          string `json:"post_code,omitempty"`
+	Gender            string `json:"gender,omitempty"`
+	IsReviewer        bool   `json:"is_reviewer,omitempty"`
+	PreferredLanguage string `json:"preferred_language,omitempty"`
 }
 
 type RegisterDetailRes struct {
-	Id         string `json:"id,omitempty"`
-	Username   string `json:"username,omitempty"`
-	FirstName  string `json:"first_name,omitempty"`
-	LastName   string `json:"last_name,omitempty"`
-	Phone      string `json:"phone,omitempty"`
-	ExtraPhone string `json:"extra_phone,omitempty"`
-	CountryId  string `json:"country_id,omitempty"`
-	CityId     string `json:"city_id,omitempty"`
-	ProfSphere string `json:"prof_sphere,omitempty"`
-	Degree     string `json:"degree,omitempty"`
-	Address    string `json:"address,omitempty"`
-	PostCode   string `json:"post_code,omitempty"`
-	Gender     string `json:"gender,omitempty"`
-	IsReviewer bool   `json:"is_reviewer,omitempty"`
+	Id                string `json:"id,omitempty"`
+	Username          string `json:"username,omitempty"`
+	FirstName         string `json:"first_name,omitempty"`
+	LastName          string `json:"last_name,omitempty"`
+	Phone             string `json:"phone,omitempty"`
+	ExtraPhone        string `json:"extra_phone,omitempty"`
+	CountryId         string `json:"country_id,omitempty"`
+	CityId            string `json:"city_id,omitempty"`
+	ProfSphere        string `json:"prof_sphere,omitempty"`
+	Degree            string `json:"degree,omitempty"`
+	Address           string `json:"address,omitempty"`
+	PostCode          string `json:"post_code,omitempty"`
+	Gender            string `json:"gender,omitempty"`
+	IsReviewer        bool   `json:"is_reviewer,omitempty"`
+	PreferredLanguage string `json:"preferred_language,omitempty"`
 }
 
 type CreateJournalUserReq struct {
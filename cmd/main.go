@@ -7,18 +7,33 @@ import (
 	"editory_submission/config"
 	"editory_submission/grpc"
 	"editory_submission/grpc/client"
+	"editory_submission/grpc/service/notification"
+	submission "editory_submission/grpc/service/submission_service"
+	"editory_submission/pkg/helper"
 	"editory_submission/storage/postgres"
+	"errors"
 	"github.com/gin-gonic/gin"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	_ "github.com/golang-migrate/migrate/v4/source/github"
 	"github.com/saidamir98/udevs_pkg/logger"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
+// shutdownTimeout limits the wait for the HTTP requests in progress on shutdown
+const shutdownTimeout = 30 * time.Second
+
 func main() {
 	cfg := config.Load()
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	loggerLevel := logger.LevelDebug
 
 	switch cfg.Environment {
@@ -85,11 +100,65 @@ func main() {
 			log.Panic("grpcServer.Serve", logger.Error(err))
 		}
 	}()
+
+	mailer, err := helper.NewMailer(cfg)
+	if err != nil {
+		log.Panic("helper.NewMailer", logger.Error(err))
+	}
+
+	outboxDone := make(chan struct{})
+	go func() {
+		notification.NewOutbox(cfg, log, pgStore, mailer).Run(ctx)
+		close(outboxDone)
+	}()
+
+	deadlinesDone := make(chan struct{})
+	go func() {
+		notification.ScheduleReviewDeadlines(ctx, cfg, log, pgStore, svcs, config.ReviewDeadlineCheckPeriod)
+		close(deadlinesDone)
+	}()
+
+	invitationsDone := make(chan struct{})
+	go func() {
+		submission.CleanupExpiredInvitations(ctx, log, pgStore, config.ReviewerInvitationCleanupPeriod)
+		close(invitationsDone)
+	}()
+
+	metricsDone := make(chan struct{})
+	go func() {
+		submission.RefreshJournalMetrics(ctx, log, pgStore, config.JournalMetricsRefreshPeriod)
+		close(metricsDone)
+	}()
+
 	h := handlers.NewHandler(cfg, log, svcs)
 
 	r := api.SetUpRouter(h, cfg)
 
-	if err = r.Run(cfg.HTTPPort); err != nil {
-		log.Panic("router.Run", logger.Error(err))
+	srv := &http.Server{
+		Addr:    cfg.HTTPPort,
+		Handler: r,
 	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Panic("router.Run", logger.Error(err))
+		}
+	}()
+
+	<-ctx.Done()
+	log.Info("Server being stopped...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Error("srv.Shutdown", logger.Error(err))
+	}
+
+	grpcServer.GracefulStop()
+
+	// the outbox stops after the mails being sent are finished
+	<-outboxDone
+	<-invitationsDone
+	<-deadlinesDone
+	<-metricsDone
 }
@@ -32,6 +32,7 @@ type Config struct {
 
 	DefaultOffset string
 	DefaultLimit  string
+	MaxLimit      int
 
 	SecretKey string
 
@@ -46,10 +47,22 @@ type Config struct {
 	EmailUsername string
 	EmailPassword string
 
+	MailDriver  string // smtp, file, memory
+	MailFrom    string
+	MailDir     string
+	SMTPHost    string
+	SMTPPort    int
+	SMTPTLSMode string // starttls, tls, none
+
+	DefaultLanguage string // en, ru, uz
+
 	MinioEndpoint        string
 	MinioAccessKeyID     string
 	MinioSecretAccessKey string
 	MinioProtocol        bool
+
+	ReviewerInvitationURL string
+	ReviewReminderDays    int
 }
 
 // Load ...
@@ -79,6 +92,7 @@ func Load() Config {
 
 	config.DefaultOffset = cast.ToString(getOrReturnDefaultValue("DEFAULT_OFFSET", "0"))
 	config.DefaultLimit = cast.ToString(getOrReturnDefaultValue("DEFAULT_LIMIT", "10"))
+	config.MaxLimit = cast.ToInt(getOrReturnDefaultValue("MAX_LIMIT", 100))
 
 	config.SecretKey = cast.ToString(getOrReturnDefaultValue("SECRET_KEY", "Here$houldBe$ome$ecretKey"))
 
@@ -90,14 +104,28 @@ func Load() Config {
 
 	config.MigrationPath = cast.ToString(getOrReturnDefaultValue("MIGRATION_PATH", "/home/euler/Documents/projects/editory_submission/migrations/postgres"))
 
-	config.EmailUsername = cast.ToString(getOrReturnDefaultValue("EMAIL_USERNAME", "editorysubmission@gmail.com"))
-	config.EmailPassword = cast.ToString(getOrReturnDefaultValue("EMAIL_PASSWORD", "occo pbku zktc oqqt"))
+	// the smtp credentials come only from the environment
+	config.EmailUsername = cast.ToString(getOrReturnDefaultValue("EMAIL_USERNAME", ""))
+	config.EmailPassword = cast.ToString(getOrReturnDefaultValue("EMAIL_PASSWORD", ""))
+
+	config.MailDriver = cast.ToString(getOrReturnDefaultValue("MAIL_DRIVER", MAIL_DRIVER_FILE))
+	config.MailFrom = cast.ToString(getOrReturnDefaultValue("MAIL_FROM", "editorysubmission@gmail.com"))
+	config.MailDir = cast.ToString(getOrReturnDefaultValue("MAIL_DIR", "./mails"))
+	config.SMTPHost = cast.ToString(getOrReturnDefaultValue("SMTP_HOST", ""))
+	config.SMTPPort = cast.ToInt(getOrReturnDefaultValue("SMTP_PORT", 587))
+	config.SMTPTLSMode = cast.ToString(getOrReturnDefaultValue("SMTP_TLS_MODE", SMTP_TLS_MODE_STARTTLS))
+
+	config.DefaultLanguage = cast.ToString(getOrReturnDefaultValue("DEFAULT_LANGUAGE", LANGUAGE_EN))
 
 	config.MinioAccessKeyID = cast.ToString(getOrReturnDefaultValue("MINIO_ACCESS_KEY", "fczbKQdzXNSjxCDu7aEatAnKjqpxHXp7km7HGveQyKCSZFPK"))
 	config.MinioSecretAccessKey = cast.ToString(getOrReturnDefaultValue("MINIO_SECRET_KEY", "kQffPzZRcEz8UNyzcV9WMEGFb2fhUAKXMxCJbCXJhKrdGLWY"))
 	config.MinioEndpoint = cast.ToString(getOrReturnDefaultValue("MINIO_ENDPOINT", "test.cdn.editorypress.uz"))
 	config.MinioProtocol = cast.ToBool(getOrReturnDefaultValue("MINIO_PROTOCOL", true))
 
+	config.ReviewerInvitationURL = cast.ToString(getOrReturnDefaultValue("REVIEWER_INVITATION_URL", "http://localhost:9107/reviewer/invitation"))
+
+	config.ReviewReminderDays = cast.ToInt(getOrReturnDefaultValue("REVIEW_REMINDER_DAYS", 3))
+
 	return config
 }
 
@@ -9,6 +9,26 @@ const (
 	DatabaseTimeLayout        string        = time.RFC3339
 	AccessTokenExpiresInTime  time.Duration = 1 * 24 * 60 * time.Minute
 	RefreshTokenExpiresInTime time.Duration = 30 * 24 * 60 * time.Minute
+
+	// DatabaseCursorTimeLayout keeps the microseconds so that the keyset cursors don't skip rows
+	DatabaseCursorTimeLayout        = `'YYYY-MM-DD"T"HH24:MI:SS.US'`
+	CursorTimeLayout         string = "2006-01-02T15:04:05.000000"
+
+	ReviewerInvitationExpiresInTime time.Duration = 7 * 24 * 60 * time.Minute
+	ReviewerInvitationCleanupPeriod time.Duration = 60 * time.Minute
+	ReviewDeadlineCheckPeriod       time.Duration = 60 * time.Minute
+	JournalMetricsRefreshPeriod     time.Duration = 6 * 60 * time.Minute
+
+	// notification outbox
+	NotificationPollPeriod    time.Duration = 5 * time.Second
+	NotificationLeaseTimeout  time.Duration = 5 * time.Minute
+	NotificationRetryDelay    time.Duration = 1 * time.Minute
+	NotificationMaxRetryDelay time.Duration = 6 * 60 * time.Minute
+	NotificationMaxAttempts                 = 8
+	NotificationBatchSize                   = 10
+
+	// DefaultReviewDueDays is used for journals which don't set their own review period
+	DefaultReviewDueDays = 21
 )
 
 const (
@@ -37,6 +57,12 @@ const (
 	ACCOUNT_DEACTIVATION  = `ACCOUNT_DEACTIVATION`
 	NEW_ARTICLE_TO_REVIEW = `NEW_ARTICLE_TO_REVIEW`
 	NEW_JOURNAL_USER      = `NEW_JOURNAL_USER`
+	REVIEW_REMINDER       = `REVIEW_REMINDER`
+	REVIEW_OVERDUE        = `REVIEW_OVERDUE`
+	REVIEW_OVERDUE_EDITOR = `REVIEW_OVERDUE_EDITOR`
+	DECISION_ACCEPT       = `DECISION_ACCEPT`
+	DECISION_REJECT       = `DECISION_REJECT`
+	DECISION_REVISE       = `DECISION_REVISE`
 )
 
 const (
@@ -44,6 +70,34 @@ const (
 	EMAIL_STATUS_PENDING = `PENDING`
 	EMAIL_STATUS_SENT    = `SENT`
 	EMAIL_STATUS_FAILED  = `FAILED`
+	EMAIL_STATUS_DEAD    = `DEAD`
+)
+
+const (
+	// in-app notification types
+	USER_NOTIFICATION_DRAFT_STATUS   = `DRAFT_STATUS`
+	USER_NOTIFICATION_REVIEW_ASSIGN  = `REVIEW_ASSIGN`
+	USER_NOTIFICATION_REVIEW_STATUS  = `REVIEW_STATUS`
+	USER_NOTIFICATION_CHECKER_STATUS = `CHECKER_STATUS`
+)
+
+const (
+	// languages of the mails
+	LANGUAGE_EN = `en`
+	LANGUAGE_RU = `ru`
+	LANGUAGE_UZ = `uz`
+)
+
+const (
+	// mail drivers
+	MAIL_DRIVER_SMTP   = `smtp`
+	MAIL_DRIVER_FILE   = `file`
+	MAIL_DRIVER_MEMORY = `memory`
+
+	// smtp tls modes
+	SMTP_TLS_MODE_STARTTLS = `starttls`
+	SMTP_TLS_MODE_TLS      = `tls`
+	SMTP_TLS_MODE_NONE     = `none`
 )
 
 const (
@@ -65,6 +119,50 @@ const (
 	ARTICLE_REVIEWER_STATUS_APPROVED            = `APPROVED`
 	ARTICLE_REVIEWER_STATUS_REJECTED            = `REJECTED`
 	ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION = `BACK_FOR_CORRECTION`
+	ARTICLE_REVIEWER_STATUS_DECLINED            = `DECLINED`
+)
+
+const (
+	// reviewer invitation status
+	INVITATION_STATUS_SENT     = `SENT`
+	INVITATION_STATUS_ACCEPTED = `ACCEPTED`
+	INVITATION_STATUS_DECLINED = `DECLINED`
+
+	// reviewer invitation link actions
+	INVITATION_ACTION_ACCEPT  = `accept`
+	INVITATION_ACTION_DECLINE = `decline`
+)
+
+const (
+	// review form question types
+	REVIEW_QUESTION_TYPE_RATING = `RATING`
+	REVIEW_QUESTION_TYPE_YES_NO = `YES_NO`
+	REVIEW_QUESTION_TYPE_TEXT   = `TEXT`
+
+	// yes/no question answers
+	REVIEW_ANSWER_YES = `yes`
+	REVIEW_ANSWER_NO  = `no`
+)
+
+const (
+	// journal review modes, single blind hides reviewers from authors, double blind also hides authors from reviewers
+	REVIEW_MODE_OPEN         = `OPEN`
+	REVIEW_MODE_SINGLE_BLIND = `SINGLE_BLIND`
+	REVIEW_MODE_DOUBLE_BLIND = `DOUBLE_BLIND`
+)
+
+const (
+	// full-text search result types
+	SEARCH_TYPE_ARTICLE = `ARTICLE`
+	SEARCH_TYPE_JOURNAL = `JOURNAL`
+	SEARCH_TYPE_DRAFT   = `DRAFT`
+)
+
+const (
+	// analytics periods
+	GRANULARITY_DAY   = `DAY`
+	GRANULARITY_WEEK  = `WEEK`
+	GRANULARITY_MONTH = `MONTH`
 )
 
 const (
@@ -77,6 +175,31 @@ const (
 	ARTICLE_EDITOR_STATUS_REJECTED_WITH_CORRECTION = `REJECTED_WITH_CORRECTION`
 )
 
+const (
+	// draft editor_status and reviewer_status
+	DRAFT_CHECK_STATUS_NEW     = `NEW`
+	DRAFT_CHECK_STATUS_PENDING = `PENDING`
+	DRAFT_CHECK_STATUS_DONE    = `DONE`
+)
+
+const (
+	// draft event types
+	DRAFT_EVENT_DRAFT_UPDATE    = `DRAFT_UPDATE`
+	DRAFT_EVENT_CHECKER_CREATE  = `CHECKER_CREATE`
+	DRAFT_EVENT_CHECKER_UPDATE  = `CHECKER_UPDATE`
+	DRAFT_EVENT_REVISION_CREATE = `REVISION_CREATE`
+
+	// SYSTEM_USER_ID is the actor of the draft events recorded by the scheduled jobs
+	SYSTEM_USER_ID = `00000000-0000-0000-0000-000000000001`
+)
+
+const (
+	// draft file types
+	FILE_TYPE_MANUSCRIPT   = `MANUSCRIPT`
+	FILE_TYPE_COVER_LETTER = `COVER_LETTER`
+	FILE_TYPE_SUPPLEMENTAL = `SUPPLEMENTAL`
+)
+
 const (
 	DRAFT_STEP_AUTHOR   = `AUTHOR`
 	DRAFT_STEP_EDITOR   = `EDITOR`
@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: review_form_service.proto
+
+package content_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ReviewFormService_CreateReviewForm_FullMethodName  = "/content_service.ReviewFormService/CreateReviewForm"
+	ReviewFormService_GetReviewForm_FullMethodName     = "/content_service.ReviewFormService/GetReviewForm"
+	ReviewFormService_GetReviewFormList_FullMethodName = "/content_service.ReviewFormService/GetReviewFormList"
+	ReviewFormService_UpdateReviewForm_FullMethodName  = "/content_service.ReviewFormService/UpdateReviewForm"
+	ReviewFormService_DeleteReviewForm_FullMethodName  = "/content_service.ReviewFormService/DeleteReviewForm"
+)
+
+// ReviewFormServiceClient is the client API for ReviewFormService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewFormServiceClient interface {
+	CreateReviewForm(ctx context.Context, in *CreateReviewFormReq, opts ...grpc.CallOption) (*ReviewForm, error)
+	GetReviewForm(ctx context.Context, in *GetReviewFormReq, opts ...grpc.CallOption) (*ReviewForm, error)
+	GetReviewFormList(ctx context.Context, in *GetReviewFormListReq, opts ...grpc.CallOption) (*GetReviewFormListRes, error)
+	UpdateReviewForm(ctx context.Context, in *UpdateReviewFormReq, opts ...grpc.CallOption) (*ReviewForm, error)
+	DeleteReviewForm(ctx context.Context, in *DeleteReviewFormReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type reviewFormServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewFormServiceClient(cc grpc.ClientConnInterface) ReviewFormServiceClient {
+	return &reviewFormServiceClient{cc}
+}
+
+func (c *reviewFormServiceClient) CreateReviewForm(ctx context.Context, in *CreateReviewFormReq, opts ...grpc.CallOption) (*ReviewForm, error) {
+	out := new(ReviewForm)
+	err := c.cc.Invoke(ctx, ReviewFormService_CreateReviewForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewFormServiceClient) GetReviewForm(ctx context.Context, in *GetReviewFormReq, opts ...grpc.CallOption) (*ReviewForm, error) {
+	out := new(ReviewForm)
+	err := c.cc.Invoke(ctx, ReviewFormService_GetReviewForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewFormServiceClient) GetReviewFormList(ctx context.Context, in *GetReviewFormListReq, opts ...grpc.CallOption) (*GetReviewFormListRes, error) {
+	out := new(GetReviewFormListRes)
+	err := c.cc.Invoke(ctx, ReviewFormService_GetReviewFormList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewFormServiceClient) UpdateReviewForm(ctx context.Context, in *UpdateReviewFormReq, opts ...grpc.CallOption) (*ReviewForm, error) {
+	out := new(ReviewForm)
+	err := c.cc.Invoke(ctx, ReviewFormService_UpdateReviewForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewFormServiceClient) DeleteReviewForm(ctx context.Context, in *DeleteReviewFormReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReviewFormService_DeleteReviewForm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewFormServiceServer is the server API for ReviewFormService service.
+// All implementations must embed UnimplementedReviewFormServiceServer
+// for forward compatibility
+type ReviewFormServiceServer interface {
+	CreateReviewForm(context.Context, *CreateReviewFormReq) (*ReviewForm, error)
+	GetReviewForm(context.Context, *GetReviewFormReq) (*ReviewForm, error)
+	GetReviewFormList(context.Context, *GetReviewFormListReq) (*GetReviewFormListRes, error)
+	UpdateReviewForm(context.Context, *UpdateReviewFormReq) (*ReviewForm, error)
+	DeleteReviewForm(context.Context, *DeleteReviewFormReq) (*emptypb.Empty, error)
+	mustEmbedUnimplementedReviewFormServiceServer()
+}
+
+// UnimplementedReviewFormServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReviewFormServiceServer struct {
+}
+
+func (UnimplementedReviewFormServiceServer) CreateReviewForm(context.Context, *CreateReviewFormReq) (*ReviewForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReviewForm not implemented")
+}
+func (UnimplementedReviewFormServiceServer) GetReviewForm(context.Context, *GetReviewFormReq) (*ReviewForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewForm not implemented")
+}
+func (UnimplementedReviewFormServiceServer) GetReviewFormList(context.Context, *GetReviewFormListReq) (*GetReviewFormListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewFormList not implemented")
+}
+func (UnimplementedReviewFormServiceServer) UpdateReviewForm(context.Context, *UpdateReviewFormReq) (*ReviewForm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReviewForm not implemented")
+}
+func (UnimplementedReviewFormServiceServer) DeleteReviewForm(context.Context, *DeleteReviewFormReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReviewForm not implemented")
+}
+func (UnimplementedReviewFormServiceServer) mustEmbedUnimplementedReviewFormServiceServer() {}
+
+// UnsafeReviewFormServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewFormServiceServer will
+// result in compilation errors.
+type UnsafeReviewFormServiceServer interface {
+	mustEmbedUnimplementedReviewFormServiceServer()
+}
+
+func RegisterReviewFormServiceServer(s grpc.ServiceRegistrar, srv ReviewFormServiceServer) {
+	s.RegisterService(&ReviewFormService_ServiceDesc, srv)
+}
+
+func _ReviewFormService_CreateReviewForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewFormReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewFormServiceServer).CreateReviewForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewFormService_CreateReviewForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewFormServiceServer).CreateReviewForm(ctx, req.(*CreateReviewFormReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewFormService_GetReviewForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewFormReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewFormServiceServer).GetReviewForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewFormService_GetReviewForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewFormServiceServer).GetReviewForm(ctx, req.(*GetReviewFormReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewFormService_GetReviewFormList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewFormListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewFormServiceServer).GetReviewFormList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewFormService_GetReviewFormList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewFormServiceServer).GetReviewFormList(ctx, req.(*GetReviewFormListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewFormService_UpdateReviewForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewFormReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewFormServiceServer).UpdateReviewForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewFormService_UpdateReviewForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewFormServiceServer).UpdateReviewForm(ctx, req.(*UpdateReviewFormReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewFormService_DeleteReviewForm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewFormReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewFormServiceServer).DeleteReviewForm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewFormService_DeleteReviewForm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewFormServiceServer).DeleteReviewForm(ctx, req.(*DeleteReviewFormReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewFormService_ServiceDesc is the grpc.ServiceDesc for ReviewFormService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewFormService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "content_service.ReviewFormService",
+	HandlerType: (*ReviewFormServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReviewForm",
+			Handler:    _ReviewFormService_CreateReviewForm_Handler,
+		},
+		{
+			MethodName: "GetReviewForm",
+			Handler:    _ReviewFormService_GetReviewForm_Handler,
+		},
+		{
+			MethodName: "GetReviewFormList",
+			Handler:    _ReviewFormService_GetReviewFormList_Handler,
+		},
+		{
+			MethodName: "UpdateReviewForm",
+			Handler:    _ReviewFormService_UpdateReviewForm_Handler,
+		},
+		{
+			MethodName: "DeleteReviewForm",
+			Handler:    _ReviewFormService_DeleteReviewForm_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "review_form_service.proto",
+}
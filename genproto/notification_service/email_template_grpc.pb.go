@@ -25,6 +25,7 @@ const (
 	EmailTmpService_GetEmailTmpList_FullMethodName = "/notification_service.EmailTmpService/GetEmailTmpList"
 	EmailTmpService_UpdateEmailTmp_FullMethodName  = "/notification_service.EmailTmpService/UpdateEmailTmp"
 	EmailTmpService_DeleteEmailTmp_FullMethodName  = "/notification_service.EmailTmpService/DeleteEmailTmp"
+	EmailTmpService_PreviewEmailTmp_FullMethodName = "/notification_service.EmailTmpService/PreviewEmailTmp"
 )
 
 // EmailTmpServiceClient is the client API for EmailTmpService service.
@@ -36,6 +37,7 @@ type EmailTmpServiceClient interface {
 	GetEmailTmpList(ctx context.Context, in *GetEmailTmpListReq, opts ...grpc.CallOption) (*GetEmailTmpListRes, error)
 	UpdateEmailTmp(ctx context.Context, in *UpdateEmailTmpReq, opts ...grpc.CallOption) (*UpdateEmailTmpRes, error)
 	DeleteEmailTmp(ctx context.Context, in *DeleteEmailTmpReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PreviewEmailTmp(ctx context.Context, in *PreviewEmailTmpReq, opts ...grpc.CallOption) (*PreviewEmailTmpRes, error)
 }
 
 type emailTmpServiceClient struct {
@@ -91,6 +93,15 @@ func (c *emailTmpServiceClient) DeleteEmailTmp(ctx context.Context, in *DeleteEm
 	return out, nil
 }
 
+func (c *emailTmpServiceClient) PreviewEmailTmp(ctx context.Context, in *PreviewEmailTmpReq, opts ...grpc.CallOption) (*PreviewEmailTmpRes, error) {
+	out := new(PreviewEmailTmpRes)
+	err := c.cc.Invoke(ctx, EmailTmpService_PreviewEmailTmp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmailTmpServiceServer is the server API for EmailTmpService service.
 // All implementations must embed UnimplementedEmailTmpServiceServer
 // for forward compatibility
@@ -100,6 +111,7 @@ type EmailTmpServiceServer interface {
 	GetEmailTmpList(context.Context, *GetEmailTmpListReq) (*GetEmailTmpListRes, error)
 	UpdateEmailTmp(context.Context, *UpdateEmailTmpReq) (*UpdateEmailTmpRes, error)
 	DeleteEmailTmp(context.Context, *DeleteEmailTmpReq) (*emptypb.Empty, error)
+	PreviewEmailTmp(context.Context, *PreviewEmailTmpReq) (*PreviewEmailTmpRes, error)
 	mustEmbedUnimplementedEmailTmpServiceServer()
 }
 
@@ -122,6 +134,9 @@ func (UnimplementedEmailTmpServiceServer) UpdateEmailTmp(context.Context, *Updat
 func (UnimplementedEmailTmpServiceServer) DeleteEmailTmp(context.Context, *DeleteEmailTmpReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmailTmp not implemented")
 }
+func (UnimplementedEmailTmpServiceServer) PreviewEmailTmp(context.Context, *PreviewEmailTmpReq) (*PreviewEmailTmpRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewEmailTmp not implemented")
+}
 func (UnimplementedEmailTmpServiceServer) mustEmbedUnimplementedEmailTmpServiceServer() {}
 
 // UnsafeEmailTmpServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -225,6 +240,24 @@ func _EmailTmpService_DeleteEmailTmp_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmailTmpService_PreviewEmailTmp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewEmailTmpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailTmpServiceServer).PreviewEmailTmp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailTmpService_PreviewEmailTmp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailTmpServiceServer).PreviewEmailTmp(ctx, req.(*PreviewEmailTmpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EmailTmpService_ServiceDesc is the grpc.ServiceDesc for EmailTmpService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -252,6 +285,10 @@ var EmailTmpService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEmailTmp",
 			Handler:    _EmailTmpService_DeleteEmailTmp_Handler,
 		},
+		{
+			MethodName: "PreviewEmailTmp",
+			Handler:    _EmailTmpService_PreviewEmailTmp_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "email_template.proto",
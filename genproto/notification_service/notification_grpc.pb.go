@@ -26,6 +26,7 @@ const (
 	NotificationService_UpdateNotification_FullMethodName  = "/notification_service.NotificationService/UpdateNotification"
 	NotificationService_DeleteNotification_FullMethodName  = "/notification_service.NotificationService/DeleteNotification"
 	NotificationService_GenerateMailMessage_FullMethodName = "/notification_service.NotificationService/GenerateMailMessage"
+	NotificationService_PreviewMailMessage_FullMethodName  = "/notification_service.NotificationService/PreviewMailMessage"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -38,6 +39,7 @@ type NotificationServiceClient interface {
 	UpdateNotification(ctx context.Context, in *UpdateNotificationReq, opts ...grpc.CallOption) (*UpdateNotificationRes, error)
 	DeleteNotification(ctx context.Context, in *DeleteNotificationReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GenerateMailMessage(ctx context.Context, in *GenerateMailMessageReq, opts ...grpc.CallOption) (*GenerateMailMessageRes, error)
+	PreviewMailMessage(ctx context.Context, in *GenerateMailMessageReq, opts ...grpc.CallOption) (*GenerateMailMessageRes, error)
 }
 
 type notificationServiceClient struct {
@@ -102,6 +104,15 @@ func (c *notificationServiceClient) GenerateMailMessage(ctx context.Context, in
 	return out, nil
 }
 
+func (c *notificationServiceClient) PreviewMailMessage(ctx context.Context, in *GenerateMailMessageReq, opts ...grpc.CallOption) (*GenerateMailMessageRes, error) {
+	out := new(GenerateMailMessageRes)
+	err := c.cc.Invoke(ctx, NotificationService_PreviewMailMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility
@@ -112,6 +123,7 @@ type NotificationServiceServer interface {
 	UpdateNotification(context.Context, *UpdateNotificationReq) (*UpdateNotificationRes, error)
 	DeleteNotification(context.Context, *DeleteNotificationReq) (*emptypb.Empty, error)
 	GenerateMailMessage(context.Context, *GenerateMailMessageReq) (*GenerateMailMessageRes, error)
+	PreviewMailMessage(context.Context, *GenerateMailMessageReq) (*GenerateMailMessageRes, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -137,6 +149,9 @@ func (UnimplementedNotificationServiceServer) DeleteNotification(context.Context
 func (UnimplementedNotificationServiceServer) GenerateMailMessage(context.Context, *GenerateMailMessageReq) (*GenerateMailMessageRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateMailMessage not implemented")
 }
+func (UnimplementedNotificationServiceServer) PreviewMailMessage(context.Context, *GenerateMailMessageReq) (*GenerateMailMessageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMailMessage not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -258,6 +273,24 @@ func _NotificationService_GenerateMailMessage_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_PreviewMailMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateMailMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PreviewMailMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PreviewMailMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PreviewMailMessage(ctx, req.(*GenerateMailMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +322,10 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateMailMessage",
 			Handler:    _NotificationService_GenerateMailMessage_Handler,
 		},
+		{
+			MethodName: "PreviewMailMessage",
+			Handler:    _NotificationService_PreviewMailMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification.proto",
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: user_notification.proto
+
+package notification_service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	UserNotificationService_CreateUserNotification_FullMethodName       = "/notification_service.UserNotificationService/CreateUserNotification"
+	UserNotificationService_GetUserNotificationList_FullMethodName      = "/notification_service.UserNotificationService/GetUserNotificationList"
+	UserNotificationService_MarkUserNotificationRead_FullMethodName     = "/notification_service.UserNotificationService/MarkUserNotificationRead"
+	UserNotificationService_MarkAllUserNotificationsRead_FullMethodName = "/notification_service.UserNotificationService/MarkAllUserNotificationsRead"
+)
+
+// UserNotificationServiceClient is the client API for UserNotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserNotificationServiceClient interface {
+	CreateUserNotification(ctx context.Context, in *CreateUserNotificationReq, opts ...grpc.CallOption) (*UserNotification, error)
+	GetUserNotificationList(ctx context.Context, in *GetUserNotificationListReq, opts ...grpc.CallOption) (*GetUserNotificationListRes, error)
+	MarkUserNotificationRead(ctx context.Context, in *MarkUserNotificationReadReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MarkAllUserNotificationsRead(ctx context.Context, in *MarkAllUserNotificationsReadReq, opts ...grpc.CallOption) (*MarkAllUserNotificationsReadRes, error)
+}
+
+type userNotificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserNotificationServiceClient(cc grpc.ClientConnInterface) UserNotificationServiceClient {
+	return &userNotificationServiceClient{cc}
+}
+
+func (c *userNotificationServiceClient) CreateUserNotification(ctx context.Context, in *CreateUserNotificationReq, opts ...grpc.CallOption) (*UserNotification, error) {
+	out := new(UserNotification)
+	err := c.cc.Invoke(ctx, UserNotificationService_CreateUserNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userNotificationServiceClient) GetUserNotificationList(ctx context.Context, in *GetUserNotificationListReq, opts ...grpc.CallOption) (*GetUserNotificationListRes, error) {
+	out := new(GetUserNotificationListRes)
+	err := c.cc.Invoke(ctx, UserNotificationService_GetUserNotificationList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userNotificationServiceClient) MarkUserNotificationRead(ctx context.Context, in *MarkUserNotificationReadReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserNotificationService_MarkUserNotificationRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userNotificationServiceClient) MarkAllUserNotificationsRead(ctx context.Context, in *MarkAllUserNotificationsReadReq, opts ...grpc.CallOption) (*MarkAllUserNotificationsReadRes, error) {
+	out := new(MarkAllUserNotificationsReadRes)
+	err := c.cc.Invoke(ctx, UserNotificationService_MarkAllUserNotificationsRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserNotificationServiceServer is the server API for UserNotificationService service.
+// All implementations must embed UnimplementedUserNotificationServiceServer
+// for forward compatibility
+type UserNotificationServiceServer interface {
+	CreateUserNotification(context.Context, *CreateUserNotificationReq) (*UserNotification, error)
+	GetUserNotificationList(context.Context, *GetUserNotificationListReq) (*GetUserNotificationListRes, error)
+	MarkUserNotificationRead(context.Context, *MarkUserNotificationReadReq) (*emptypb.Empty, error)
+	MarkAllUserNotificationsRead(context.Context, *MarkAllUserNotificationsReadReq) (*MarkAllUserNotificationsReadRes, error)
+	mustEmbedUnimplementedUserNotificationServiceServer()
+}
+
+// UnimplementedUserNotificationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserNotificationServiceServer struct {
+}
+
+func (UnimplementedUserNotificationServiceServer) CreateUserNotification(context.Context, *CreateUserNotificationReq) (*UserNotification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserNotification not implemented")
+}
+func (UnimplementedUserNotificationServiceServer) GetUserNotificationList(context.Context, *GetUserNotificationListReq) (*GetUserNotificationListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserNotificationList not implemented")
+}
+func (UnimplementedUserNotificationServiceServer) MarkUserNotificationRead(context.Context, *MarkUserNotificationReadReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkUserNotificationRead not implemented")
+}
+func (UnimplementedUserNotificationServiceServer) MarkAllUserNotificationsRead(context.Context, *MarkAllUserNotificationsReadReq) (*MarkAllUserNotificationsReadRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllUserNotificationsRead not implemented")
+}
+func (UnimplementedUserNotificationServiceServer) mustEmbedUnimplementedUserNotificationServiceServer() {
+}
+
+// UnsafeUserNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserNotificationServiceServer will
+// result in compilation errors.
+type UnsafeUserNotificationServiceServer interface {
+	mustEmbedUnimplementedUserNotificationServiceServer()
+}
+
+func RegisterUserNotificationServiceServer(s grpc.ServiceRegistrar, srv UserNotificationServiceServer) {
+	s.RegisterService(&UserNotificationService_ServiceDesc, srv)
+}
+
+func _UserNotificationService_CreateUserNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserNotificationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationServiceServer).CreateUserNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationService_CreateUserNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationServiceServer).CreateUserNotification(ctx, req.(*CreateUserNotificationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserNotificationService_GetUserNotificationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserNotificationListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationServiceServer).GetUserNotificationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationService_GetUserNotificationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationServiceServer).GetUserNotificationList(ctx, req.(*GetUserNotificationListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserNotificationService_MarkUserNotificationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkUserNotificationReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationServiceServer).MarkUserNotificationRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationService_MarkUserNotificationRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationServiceServer).MarkUserNotificationRead(ctx, req.(*MarkUserNotificationReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserNotificationService_MarkAllUserNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllUserNotificationsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationServiceServer).MarkAllUserNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationService_MarkAllUserNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationServiceServer).MarkAllUserNotificationsRead(ctx, req.(*MarkAllUserNotificationsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserNotificationService_ServiceDesc is the grpc.ServiceDesc for UserNotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserNotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "notification_service.UserNotificationService",
+	HandlerType: (*UserNotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUserNotification",
+			Handler:    _UserNotificationService_CreateUserNotification_Handler,
+		},
+		{
+			MethodName: "GetUserNotificationList",
+			Handler:    _UserNotificationService_GetUserNotificationList_Handler,
+		},
+		{
+			MethodName: "MarkUserNotificationRead",
+			Handler:    _UserNotificationService_MarkUserNotificationRead_Handler,
+		},
+		{
+			MethodName: "MarkAllUserNotificationsRead",
+			Handler:    _UserNotificationService_MarkAllUserNotificationsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user_notification.proto",
+}
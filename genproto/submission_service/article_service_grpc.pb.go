@@ -20,17 +20,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ArticleService_CreateArticle_FullMethodName  = "/submission_service.ArticleService/CreateArticle"
-	ArticleService_GetArticle_FullMethodName     = "/submission_service.ArticleService/GetArticle"
-	ArticleService_GetArticleList_FullMethodName = "/submission_service.ArticleService/GetArticleList"
-	ArticleService_UpdateArticle_FullMethodName  = "/submission_service.ArticleService/UpdateArticle"
-	ArticleService_DeleteArticle_FullMethodName  = "/submission_service.ArticleService/DeleteArticle"
-	ArticleService_AddFiles_FullMethodName       = "/submission_service.ArticleService/AddFiles"
-	ArticleService_GetFiles_FullMethodName       = "/submission_service.ArticleService/GetFiles"
-	ArticleService_DeleteFiles_FullMethodName    = "/submission_service.ArticleService/DeleteFiles"
-	ArticleService_AddCoAuthor_FullMethodName    = "/submission_service.ArticleService/AddCoAuthor"
-	ArticleService_GetCoAuthors_FullMethodName   = "/submission_service.ArticleService/GetCoAuthors"
-	ArticleService_DeleteCoAuthor_FullMethodName = "/submission_service.ArticleService/DeleteCoAuthor"
+	ArticleService_CreateArticle_FullMethodName         = "/submission_service.ArticleService/CreateArticle"
+	ArticleService_GetArticle_FullMethodName            = "/submission_service.ArticleService/GetArticle"
+	ArticleService_GetArticleList_FullMethodName        = "/submission_service.ArticleService/GetArticleList"
+	ArticleService_UpdateArticle_FullMethodName         = "/submission_service.ArticleService/UpdateArticle"
+	ArticleService_DeleteArticle_FullMethodName         = "/submission_service.ArticleService/DeleteArticle"
+	ArticleService_SubmitDraft_FullMethodName           = "/submission_service.ArticleService/SubmitDraft"
+	ArticleService_UpdateDraft_FullMethodName           = "/submission_service.ArticleService/UpdateDraft"
+	ArticleService_GetDraftHistory_FullMethodName       = "/submission_service.ArticleService/GetDraftHistory"
+	ArticleService_SubmitRevision_FullMethodName        = "/submission_service.ArticleService/SubmitRevision"
+	ArticleService_PreviewDecisionLetter_FullMethodName = "/submission_service.ArticleService/PreviewDecisionLetter"
+	ArticleService_GetJournalStats_FullMethodName       = "/submission_service.ArticleService/GetJournalStats"
+	ArticleService_AddFiles_FullMethodName              = "/submission_service.ArticleService/AddFiles"
+	ArticleService_GetFiles_FullMethodName              = "/submission_service.ArticleService/GetFiles"
+	ArticleService_DeleteFiles_FullMethodName           = "/submission_service.ArticleService/DeleteFiles"
+	ArticleService_AddCoAuthor_FullMethodName           = "/submission_service.ArticleService/AddCoAuthor"
+	ArticleService_GetCoAuthors_FullMethodName          = "/submission_service.ArticleService/GetCoAuthors"
+	ArticleService_DeleteCoAuthor_FullMethodName        = "/submission_service.ArticleService/DeleteCoAuthor"
 )
 
 // ArticleServiceClient is the client API for ArticleService service.
@@ -43,6 +49,12 @@ type ArticleServiceClient interface {
 	GetArticleList(ctx context.Context, in *GetArticleListReq, opts ...grpc.CallOption) (*GetArticleListRes, error)
 	UpdateArticle(ctx context.Context, in *UpdateArticleReq, opts ...grpc.CallOption) (*UpdateArticleRes, error)
 	DeleteArticle(ctx context.Context, in *DeleteArticleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SubmitDraft(ctx context.Context, in *SubmitDraftReq, opts ...grpc.CallOption) (*GetArticleRes, error)
+	UpdateDraft(ctx context.Context, in *UpdateDraftReq, opts ...grpc.CallOption) (*GetArticleRes, error)
+	GetDraftHistory(ctx context.Context, in *GetDraftHistoryReq, opts ...grpc.CallOption) (*GetDraftHistoryRes, error)
+	SubmitRevision(ctx context.Context, in *SubmitRevisionReq, opts ...grpc.CallOption) (*GetArticleRes, error)
+	PreviewDecisionLetter(ctx context.Context, in *PreviewDecisionLetterReq, opts ...grpc.CallOption) (*DecisionLetter, error)
+	GetJournalStats(ctx context.Context, in *GetJournalStatsReq, opts ...grpc.CallOption) (*JournalStats, error)
 	// File
 	AddFiles(ctx context.Context, in *AddFilesReq, opts ...grpc.CallOption) (*AddFilesRes, error)
 	GetFiles(ctx context.Context, in *GetFilesReq, opts ...grpc.CallOption) (*GetFilesRes, error)
@@ -106,6 +118,60 @@ func (c *articleServiceClient) DeleteArticle(ctx context.Context, in *DeleteArti
 	return out, nil
 }
 
+func (c *articleServiceClient) SubmitDraft(ctx context.Context, in *SubmitDraftReq, opts ...grpc.CallOption) (*GetArticleRes, error) {
+	out := new(GetArticleRes)
+	err := c.cc.Invoke(ctx, ArticleService_SubmitDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UpdateDraft(ctx context.Context, in *UpdateDraftReq, opts ...grpc.CallOption) (*GetArticleRes, error) {
+	out := new(GetArticleRes)
+	err := c.cc.Invoke(ctx, ArticleService_UpdateDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetDraftHistory(ctx context.Context, in *GetDraftHistoryReq, opts ...grpc.CallOption) (*GetDraftHistoryRes, error) {
+	out := new(GetDraftHistoryRes)
+	err := c.cc.Invoke(ctx, ArticleService_GetDraftHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) SubmitRevision(ctx context.Context, in *SubmitRevisionReq, opts ...grpc.CallOption) (*GetArticleRes, error) {
+	out := new(GetArticleRes)
+	err := c.cc.Invoke(ctx, ArticleService_SubmitRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) PreviewDecisionLetter(ctx context.Context, in *PreviewDecisionLetterReq, opts ...grpc.CallOption) (*DecisionLetter, error) {
+	out := new(DecisionLetter)
+	err := c.cc.Invoke(ctx, ArticleService_PreviewDecisionLetter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetJournalStats(ctx context.Context, in *GetJournalStatsReq, opts ...grpc.CallOption) (*JournalStats, error) {
+	out := new(JournalStats)
+	err := c.cc.Invoke(ctx, ArticleService_GetJournalStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) AddFiles(ctx context.Context, in *AddFilesReq, opts ...grpc.CallOption) (*AddFilesRes, error) {
 	out := new(AddFilesRes)
 	err := c.cc.Invoke(ctx, ArticleService_AddFiles_FullMethodName, in, out, opts...)
@@ -170,6 +236,12 @@ type ArticleServiceServer interface {
 	GetArticleList(context.Context, *GetArticleListReq) (*GetArticleListRes, error)
 	UpdateArticle(context.Context, *UpdateArticleReq) (*UpdateArticleRes, error)
 	DeleteArticle(context.Context, *DeleteArticleReq) (*emptypb.Empty, error)
+	SubmitDraft(context.Context, *SubmitDraftReq) (*GetArticleRes, error)
+	UpdateDraft(context.Context, *UpdateDraftReq) (*GetArticleRes, error)
+	GetDraftHistory(context.Context, *GetDraftHistoryReq) (*GetDraftHistoryRes, error)
+	SubmitRevision(context.Context, *SubmitRevisionReq) (*GetArticleRes, error)
+	PreviewDecisionLetter(context.Context, *PreviewDecisionLetterReq) (*DecisionLetter, error)
+	GetJournalStats(context.Context, *GetJournalStatsReq) (*JournalStats, error)
 	// File
 	AddFiles(context.Context, *AddFilesReq) (*AddFilesRes, error)
 	GetFiles(context.Context, *GetFilesReq) (*GetFilesRes, error)
@@ -200,6 +272,24 @@ func (UnimplementedArticleServiceServer) UpdateArticle(context.Context, *UpdateA
 func (UnimplementedArticleServiceServer) DeleteArticle(context.Context, *DeleteArticleReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
 }
+func (UnimplementedArticleServiceServer) SubmitDraft(context.Context, *SubmitDraftReq) (*GetArticleRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDraft not implemented")
+}
+func (UnimplementedArticleServiceServer) UpdateDraft(context.Context, *UpdateDraftReq) (*GetArticleRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDraft not implemented")
+}
+func (UnimplementedArticleServiceServer) GetDraftHistory(context.Context, *GetDraftHistoryReq) (*GetDraftHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraftHistory not implemented")
+}
+func (UnimplementedArticleServiceServer) SubmitRevision(context.Context, *SubmitRevisionReq) (*GetArticleRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitRevision not implemented")
+}
+func (UnimplementedArticleServiceServer) PreviewDecisionLetter(context.Context, *PreviewDecisionLetterReq) (*DecisionLetter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewDecisionLetter not implemented")
+}
+func (UnimplementedArticleServiceServer) GetJournalStats(context.Context, *GetJournalStatsReq) (*JournalStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalStats not implemented")
+}
 func (UnimplementedArticleServiceServer) AddFiles(context.Context, *AddFilesReq) (*AddFilesRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFiles not implemented")
 }
@@ -321,6 +411,114 @@ func _ArticleService_DeleteArticle_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_SubmitDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SubmitDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SubmitDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SubmitDraft(ctx, req.(*SubmitDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UpdateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UpdateDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UpdateDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UpdateDraft(ctx, req.(*UpdateDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetDraftHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetDraftHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetDraftHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetDraftHistory(ctx, req.(*GetDraftHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_SubmitRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).SubmitRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_SubmitRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).SubmitRevision(ctx, req.(*SubmitRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_PreviewDecisionLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewDecisionLetterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).PreviewDecisionLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_PreviewDecisionLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).PreviewDecisionLetter(ctx, req.(*PreviewDecisionLetterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetJournalStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetJournalStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetJournalStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetJournalStats(ctx, req.(*GetJournalStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArticleService_AddFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddFilesReq)
 	if err := dec(in); err != nil {
@@ -456,6 +654,30 @@ var ArticleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArticle",
 			Handler:    _ArticleService_DeleteArticle_Handler,
 		},
+		{
+			MethodName: "SubmitDraft",
+			Handler:    _ArticleService_SubmitDraft_Handler,
+		},
+		{
+			MethodName: "UpdateDraft",
+			Handler:    _ArticleService_UpdateDraft_Handler,
+		},
+		{
+			MethodName: "GetDraftHistory",
+			Handler:    _ArticleService_GetDraftHistory_Handler,
+		},
+		{
+			MethodName: "SubmitRevision",
+			Handler:    _ArticleService_SubmitRevision_Handler,
+		},
+		{
+			MethodName: "PreviewDecisionLetter",
+			Handler:    _ArticleService_PreviewDecisionLetter_Handler,
+		},
+		{
+			MethodName: "GetJournalStats",
+			Handler:    _ArticleService_GetJournalStats_Handler,
+		},
 		{
 			MethodName: "AddFiles",
 			Handler:    _ArticleService_AddFiles_Handler,
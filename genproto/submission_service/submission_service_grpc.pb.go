@@ -20,11 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CheckerService_CreateArticleChecker_FullMethodName  = "/submission_service.CheckerService/CreateArticleChecker"
-	CheckerService_GetArticleChecker_FullMethodName     = "/submission_service.CheckerService/GetArticleChecker"
-	CheckerService_GetArticleCheckerList_FullMethodName = "/submission_service.CheckerService/GetArticleCheckerList"
-	CheckerService_UpdateArticleChecker_FullMethodName  = "/submission_service.CheckerService/UpdateArticleChecker"
-	CheckerService_DeleteArticleChecker_FullMethodName  = "/submission_service.CheckerService/DeleteArticleChecker"
+	CheckerService_CreateArticleChecker_FullMethodName      = "/submission_service.CheckerService/CreateArticleChecker"
+	CheckerService_GetArticleChecker_FullMethodName         = "/submission_service.CheckerService/GetArticleChecker"
+	CheckerService_GetArticleCheckerList_FullMethodName     = "/submission_service.CheckerService/GetArticleCheckerList"
+	CheckerService_UpdateArticleChecker_FullMethodName      = "/submission_service.CheckerService/UpdateArticleChecker"
+	CheckerService_DeleteArticleChecker_FullMethodName      = "/submission_service.CheckerService/DeleteArticleChecker"
+	CheckerService_RespondReviewerInvitation_FullMethodName = "/submission_service.CheckerService/RespondReviewerInvitation"
+	CheckerService_GetReviewerSuggestions_FullMethodName    = "/submission_service.CheckerService/GetReviewerSuggestions"
 )
 
 // CheckerServiceClient is the client API for CheckerService service.
@@ -36,6 +38,8 @@ type CheckerServiceClient interface {
 	GetArticleCheckerList(ctx context.Context, in *GetArticleCheckerListReq, opts ...grpc.CallOption) (*GetArticleCheckerListRes, error)
 	UpdateArticleChecker(ctx context.Context, in *UpdateArticleCheckerReq, opts ...grpc.CallOption) (*UpdateArticleCheckerRes, error)
 	DeleteArticleChecker(ctx context.Context, in *DeleteArticleCheckerReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RespondReviewerInvitation(ctx context.Context, in *RespondReviewerInvitationReq, opts ...grpc.CallOption) (*ReviewerInvitation, error)
+	GetReviewerSuggestions(ctx context.Context, in *GetReviewerSuggestionsReq, opts ...grpc.CallOption) (*GetReviewerSuggestionsRes, error)
 }
 
 type checkerServiceClient struct {
@@ -91,6 +95,24 @@ func (c *checkerServiceClient) DeleteArticleChecker(ctx context.Context, in *Del
 	return out, nil
 }
 
+func (c *checkerServiceClient) RespondReviewerInvitation(ctx context.Context, in *RespondReviewerInvitationReq, opts ...grpc.CallOption) (*ReviewerInvitation, error) {
+	out := new(ReviewerInvitation)
+	err := c.cc.Invoke(ctx, CheckerService_RespondReviewerInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkerServiceClient) GetReviewerSuggestions(ctx context.Context, in *GetReviewerSuggestionsReq, opts ...grpc.CallOption) (*GetReviewerSuggestionsRes, error) {
+	out := new(GetReviewerSuggestionsRes)
+	err := c.cc.Invoke(ctx, CheckerService_GetReviewerSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CheckerServiceServer is the server API for CheckerService service.
 // All implementations must embed UnimplementedCheckerServiceServer
 // for forward compatibility
@@ -100,6 +122,8 @@ type CheckerServiceServer interface {
 	GetArticleCheckerList(context.Context, *GetArticleCheckerListReq) (*GetArticleCheckerListRes, error)
 	UpdateArticleChecker(context.Context, *UpdateArticleCheckerReq) (*UpdateArticleCheckerRes, error)
 	DeleteArticleChecker(context.Context, *DeleteArticleCheckerReq) (*emptypb.Empty, error)
+	RespondReviewerInvitation(context.Context, *RespondReviewerInvitationReq) (*ReviewerInvitation, error)
+	GetReviewerSuggestions(context.Context, *GetReviewerSuggestionsReq) (*GetReviewerSuggestionsRes, error)
 	mustEmbedUnimplementedCheckerServiceServer()
 }
 
@@ -122,6 +146,12 @@ func (UnimplementedCheckerServiceServer) UpdateArticleChecker(context.Context, *
 func (UnimplementedCheckerServiceServer) DeleteArticleChecker(context.Context, *DeleteArticleCheckerReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticleChecker not implemented")
 }
+func (UnimplementedCheckerServiceServer) RespondReviewerInvitation(context.Context, *RespondReviewerInvitationReq) (*ReviewerInvitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondReviewerInvitation not implemented")
+}
+func (UnimplementedCheckerServiceServer) GetReviewerSuggestions(context.Context, *GetReviewerSuggestionsReq) (*GetReviewerSuggestionsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewerSuggestions not implemented")
+}
 func (UnimplementedCheckerServiceServer) mustEmbedUnimplementedCheckerServiceServer() {}
 
 // UnsafeCheckerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -225,6 +255,42 @@ func _CheckerService_DeleteArticleChecker_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CheckerService_RespondReviewerInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondReviewerInvitationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckerServiceServer).RespondReviewerInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckerService_RespondReviewerInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckerServiceServer).RespondReviewerInvitation(ctx, req.(*RespondReviewerInvitationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckerService_GetReviewerSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewerSuggestionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckerServiceServer).GetReviewerSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckerService_GetReviewerSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckerServiceServer).GetReviewerSuggestions(ctx, req.(*GetReviewerSuggestionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CheckerService_ServiceDesc is the grpc.ServiceDesc for CheckerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -252,6 +318,14 @@ var CheckerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArticleChecker",
 			Handler:    _CheckerService_DeleteArticleChecker_Handler,
 		},
+		{
+			MethodName: "RespondReviewerInvitation",
+			Handler:    _CheckerService_RespondReviewerInvitation_Handler,
+		},
+		{
+			MethodName: "GetReviewerSuggestions",
+			Handler:    _CheckerService_GetReviewerSuggestions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "submission_service.proto",
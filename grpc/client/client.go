@@ -15,30 +15,40 @@ type ServiceManagerI interface {
 	SessionService() auth_service.SessionServiceClient
 	RoleService() auth_service.RoleServiceClient
 	KeywordService() auth_service.KeywordServiceClient
+	PermissionService() auth_service.PermissionServiceClient
 	ContentService() content_service.ContentServiceClient
 	UniversityService() content_service.UniversityServiceClient
 	SubjectService() content_service.SubjectServiceClient
+	ReviewFormService() content_service.ReviewFormServiceClient
+	SearchService() content_service.SearchServiceClient
+	AnalyticsService() content_service.AnalyticsServiceClient
 	NotificationService() notification_service.NotificationServiceClient
 	EmailTmpService() notification_service.EmailTmpServiceClient
+	UserNotificationService() notification_service.UserNotificationServiceClient
 	ArticleService() submission_service.ArticleServiceClient
 	CheckerService() submission_service.CheckerServiceClient
 }
 
 type grpcClients struct {
 	// auth
-	userService    auth_service.UserServiceClient
-	sessionService auth_service.SessionServiceClient
-	roleService    auth_service.RoleServiceClient
-	keywordService auth_service.KeywordServiceClient
+	userService       auth_service.UserServiceClient
+	sessionService    auth_service.SessionServiceClient
+	roleService       auth_service.RoleServiceClient
+	keywordService    auth_service.KeywordServiceClient
+	permissionService auth_service.PermissionServiceClient
 
 	// content
 	contentService    content_service.ContentServiceClient
 	universityService content_service.UniversityServiceClient
 	subjectService    content_service.SubjectServiceClient
+	reviewFormService content_service.ReviewFormServiceClient
+	searchService     content_service.SearchServiceClient
+	analyticsService  content_service.AnalyticsServiceClient
 
 	// notification
-	notificationService notification_service.NotificationServiceClient
-	emailTmpService     notification_service.EmailTmpServiceClient
+	notificationService     notification_service.NotificationServiceClient
+	emailTmpService         notification_service.EmailTmpServiceClient
+	userNotificationService notification_service.UserNotificationServiceClient
 
 	// submission
 	articleService submission_service.ArticleServiceClient
@@ -56,17 +66,22 @@ func NewGrpcClients(cfg config.Config) (ServiceManagerI, error) {
 	}
 
 	return &grpcClients{
-		userService:         auth_service.NewUserServiceClient(connAuthService),
-		sessionService:      auth_service.NewSessionServiceClient(connAuthService),
-		roleService:         auth_service.NewRoleServiceClient(connAuthService),
-		keywordService:      auth_service.NewKeywordServiceClient(connAuthService),
-		contentService:      content_service.NewContentServiceClient(connAuthService),
-		universityService:   content_service.NewUniversityServiceClient(connAuthService),
-		subjectService:      content_service.NewSubjectServiceClient(connAuthService),
-		emailTmpService:     notification_service.NewEmailTmpServiceClient(connAuthService),
-		notificationService: notification_service.NewNotificationServiceClient(connAuthService),
-		articleService:      submission_service.NewArticleServiceClient(connAuthService),
-		checkerService:      submission_service.NewCheckerServiceClient(connAuthService),
+		userService:             auth_service.NewUserServiceClient(connAuthService),
+		sessionService:          auth_service.NewSessionServiceClient(connAuthService),
+		roleService:             auth_service.NewRoleServiceClient(connAuthService),
+		keywordService:          auth_service.NewKeywordServiceClient(connAuthService),
+		permissionService:       auth_service.NewPermissionServiceClient(connAuthService),
+		contentService:          content_service.NewContentServiceClient(connAuthService),
+		universityService:       content_service.NewUniversityServiceClient(connAuthService),
+		subjectService:          content_service.NewSubjectServiceClient(connAuthService),
+		reviewFormService:       content_service.NewReviewFormServiceClient(connAuthService),
+		searchService:           content_service.NewSearchServiceClient(connAuthService),
+		analyticsService:        content_service.NewAnalyticsServiceClient(connAuthService),
+		emailTmpService:         notification_service.NewEmailTmpServiceClient(connAuthService),
+		notificationService:     notification_service.NewNotificationServiceClient(connAuthService),
+		userNotificationService: notification_service.NewUserNotificationServiceClient(connAuthService),
+		articleService:          submission_service.NewArticleServiceClient(connAuthService),
+		checkerService:          submission_service.NewCheckerServiceClient(connAuthService),
 	}, nil
 }
 
@@ -90,6 +105,18 @@ func (g *grpcClients) SubjectService() content_service.SubjectServiceClient {
 	return g.subjectService
 }
 
+func (g *grpcClients) ReviewFormService() content_service.ReviewFormServiceClient {
+	return g.reviewFormService
+}
+
+func (g *grpcClients) SearchService() content_service.SearchServiceClient {
+	return g.searchService
+}
+
+func (g *grpcClients) AnalyticsService() content_service.AnalyticsServiceClient {
+	return g.analyticsService
+}
+
 func (g *grpcClients) RoleService() auth_service.RoleServiceClient {
 	return g.roleService
 }
@@ -98,6 +125,10 @@ func (g *grpcClients) KeywordService() auth_service.KeywordServiceClient {
 	return g.keywordService
 }
 
+func (g *grpcClients) PermissionService() auth_service.PermissionServiceClient {
+	return g.permissionService
+}
+
 func (g *grpcClients) NotificationService() notification_service.NotificationServiceClient {
 	return g.notificationService
 }
@@ -106,6 +137,10 @@ func (g *grpcClients) EmailTmpService() notification_service.EmailTmpServiceClie
 	return g.emailTmpService
 }
 
+func (g *grpcClients) UserNotificationService() notification_service.UserNotificationServiceClient {
+	return g.userNotificationService
+}
+
 func (g *grpcClients) ArticleService() submission_service.ArticleServiceClient {
 	return g.articleService
 }
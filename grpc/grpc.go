@@ -26,15 +26,20 @@ func SetUpServer(cfg config.Config, log logger.LoggerI, strg storage.StorageI, s
 	auth_service.RegisterSessionServiceServer(grpcServer, auth.NewSessionService(cfg, log, strg, svcs))
 	auth_service.RegisterRoleServiceServer(grpcServer, auth.NewRoleService(cfg, log, strg, svcs))
 	auth_service.RegisterKeywordServiceServer(grpcServer, auth.NewKeywordService(cfg, log, strg, svcs))
+	auth_service.RegisterPermissionServiceServer(grpcServer, auth.NewPermissionService(cfg, log, strg, svcs))
 
 	// content
 	content_service.RegisterContentServiceServer(grpcServer, content.NewContentService(cfg, log, strg, svcs))
 	content_service.RegisterUniversityServiceServer(grpcServer, content.NewUniversityService(cfg, log, strg, svcs))
 	content_service.RegisterSubjectServiceServer(grpcServer, content.NewSubjectService(cfg, log, strg, svcs))
+	content_service.RegisterReviewFormServiceServer(grpcServer, content.NewReviewFormService(cfg, log, strg, svcs))
+	content_service.RegisterSearchServiceServer(grpcServer, content.NewSearchService(cfg, log, strg, svcs))
+	content_service.RegisterAnalyticsServiceServer(grpcServer, content.NewAnalyticsService(cfg, log, strg, svcs))
 
 	// notification
 	notification_service.RegisterEmailTmpServiceServer(grpcServer, notification.NewEmailTmpService(cfg, log, strg, svcs))
 	notification_service.RegisterNotificationServiceServer(grpcServer, notification.NewNotificationService(cfg, log, strg, svcs))
+	notification_service.RegisterUserNotificationServiceServer(grpcServer, notification.NewUserNotificationService(cfg, log, strg, svcs))
 
 	// submission
 	submission_service.RegisterArticleServiceServer(grpcServer, submission.NewArticleService(cfg, log, strg, svcs))
@@ -0,0 +1,109 @@
+package auth_service
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/auth_service"
+	"editory_submission/grpc/client"
+	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type permissionService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	pb.UnimplementedPermissionServiceServer
+}
+
+func NewPermissionService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, svcs client.ServiceManagerI) *permissionService {
+	return &permissionService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: svcs,
+	}
+}
+
+func (s *permissionService) CreatePermission(ctx context.Context, req *pb.CreatePermissionReq) (res *pb.Permission, err error) {
+	s.log.Info("---CreatePermission--->", logger.Any("req", req))
+
+	if req.GetMethod() == "" {
+		req.Method = "*"
+	}
+
+	res, err = s.strg.Auth().Permission().Create(ctx, req)
+	if err != nil {
+		s.log.Error("!!!CreatePermission--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *permissionService) GetPermission(ctx context.Context, req *pb.GetPermissionReq) (res *pb.Permission, err error) {
+	s.log.Info("---GetPermission--->", logger.Any("req", req))
+
+	res, err = s.strg.Auth().Permission().Get(ctx, req)
+	if err != nil {
+		s.log.Error("!!!GetPermission--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *permissionService) GetPermissionList(ctx context.Context, req *pb.GetPermissionListReq) (res *pb.GetPermissionListRes, err error) {
+	s.log.Info("---GetPermissionList--->", logger.Any("req", req))
+
+	res, err = s.strg.Auth().Permission().GetList(ctx, req)
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetPermissionList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		s.log.Error("!!!GetPermissionList--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *permissionService) UpdatePermission(ctx context.Context, req *pb.Permission) (res *pb.Permission, err error) {
+	s.log.Info("---UpdatePermission--->", logger.Any("req", req))
+
+	if req.GetMethod() == "" {
+		req.Method = "*"
+	}
+
+	res, err = s.strg.Auth().Permission().Update(ctx, req)
+	if err != nil {
+		s.log.Error("!!!UpdatePermission--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *permissionService) DeletePermission(ctx context.Context, req *pb.DeletePermissionReq) (res *emptypb.Empty, err error) {
+	s.log.Info("---DeletePermission--->", logger.Any("req", req))
+
+	res = &emptypb.Empty{}
+
+	rowsAffected, err := s.strg.Auth().Permission().Delete(ctx, req)
+	if err != nil {
+		s.log.Error("!!!DeletePermission--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	return res, nil
+}
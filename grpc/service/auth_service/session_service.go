@@ -10,7 +10,6 @@ import (
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
 	"errors"
-	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -297,8 +296,6 @@ func (s *sessionService) HasAccess(ctx context.Context, req *pb.HasAccessReq) (*
 		s.log.Error("!!!HasAccess--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	fmt.Println(session)
-
 	if !util.IsValidUUID(session.GetRoleId()) {
 		err := errors.New("not valid session role")
 		s.log.Error("!!!HasAccess--->", logger.Error(err))
@@ -319,29 +316,25 @@ func (s *sessionService) HasAccess(ctx context.Context, req *pb.HasAccessReq) (*
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	//_, err = s.strg.Scope().Upsert(ctx, &pb.UpsertScopeRequest{
-	//	ClientPlatformId: req.ClientPlatformId,
-	//	Path:             req.Path,
-	//	Method:           req.Method,
-	//})
-	//if err != nil {
-	//	s.log.Error("!!!HasAccess--->", logger.Error(err))
-	//	return nil, status.Error(codes.Internal, err.Error())
-	//}
-
-	// DONT FORGET TO UNCOMMENT THIS!!!
+	role, err := s.strg.Auth().Role().Get(ctx, &pb.GetRoleReq{
+		Id: session.GetRoleId(),
+	})
+	if err != nil {
+		s.log.Error("!!!HasAccess--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	// hasAccess, err := s.strg.PermissionScope().HasAccess(ctx, user.RoleId, req.ClientPlatformId, req.Path, req.Method)
-	// if err != nil {
-	// 	s.log.Error("!!!HasAccess--->", logger.Error(err))
-	// 	return nil, status.Error(codes.InvalidArgument, err.Error())
-	// }
+	hasAccess, err := s.strg.Auth().Permission().HasAccess(ctx, role.GetRoleType(), req.GetPath(), req.GetMethod())
+	if err != nil {
+		s.log.Error("!!!HasAccess--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	// if !hasAccess {
-	// 	err = errors.New("access denied")
-	// 	s.log.Error("!!!HasAccess--->", logger.Error(err))
-	// 	return nil, status.Error(codes.InvalidArgument, err.Error())
-	// }
+	if !hasAccess {
+		err = errors.New("access denied")
+		s.log.Error("!!!HasAccess--->", logger.Error(err))
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 
 	return &pb.HasAccessRes{
 		Id:        session.Id,
@@ -352,5 +345,7 @@ func (s *sessionService) HasAccess(ctx context.Context, req *pb.HasAccessReq) (*
 		ExpiresAt: session.ExpiresAt,
 		CreatedAt: session.CreatedAt,
 		UpdatedAt: session.UpdatedAt,
+		RoleType:  role.GetRoleType(),
+		JournalId: role.GetJournalId(),
 	}, nil
 }
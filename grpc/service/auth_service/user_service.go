@@ -5,6 +5,7 @@ import (
 	"editory_submission/config"
 	pb "editory_submission/genproto/auth_service"
 	"editory_submission/grpc/client"
+	"editory_submission/pkg/helper"
 	"editory_submission/pkg/security"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
@@ -65,37 +66,49 @@ func (s *userService) CreateUser(ctx context.Context, req *pb.User) (res *pb.Use
 		req.ExtraPhone = ""
 	}
 
-	res, err = s.strg.Auth().User().Create(ctx, req)
-	if err != nil {
+	if req.GetPreferredLanguage() != "" && !util.IsValidLanguage(req.GetPreferredLanguage()) {
+		err = errors.New("preferred language must be one of en, ru, uz")
 		s.log.Error("!!!CreateUser--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	author := false
-
-	for _, v := range req.GetRole() {
-		if v.GetRoleType() == "AUTHOR" {
-			author = true
-		}
-		_, err = s.strg.Auth().Role().Create(ctx, &pb.Role{
-			UserId:    res.GetId(),
-			RoleType:  v.GetRoleType(),
-			JournalId: v.GetJournalId(),
-		})
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		res, err = strg.Auth().User().Create(ctx, req)
 		if err != nil {
-			s.log.Error("!!!CreateRole--->", logger.Error(err))
-			continue
+			return err
 		}
-	}
 
-	if !author {
-		_, err = s.strg.Auth().Role().Create(ctx, &pb.Role{
-			UserId:   res.GetId(),
-			RoleType: "AUTHOR",
-		})
-		if err != nil {
-			s.log.Error("!!!CreateRole--->", logger.Error(err))
+		author := false
+
+		for _, v := range req.GetRole() {
+			if v.GetRoleType() == config.AUTHOR {
+				author = true
+			}
+			_, err = strg.Auth().Role().Create(ctx, &pb.Role{
+				UserId:    res.GetId(),
+				RoleType:  v.GetRoleType(),
+				JournalId: v.GetJournalId(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !author {
+			_, err = strg.Auth().Role().Create(ctx, &pb.Role{
+				UserId:   res.GetId(),
+				RoleType: config.AUTHOR,
+			})
+			if err != nil {
+				return err
+			}
 		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("!!!CreateUser--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return res, nil
@@ -128,7 +141,10 @@ func (s *userService) GetUserList(ctx context.Context, req *pb.GetUserListReq) (
 	s.log.Info("---GetUserList--->", logger.Any("req", req))
 
 	res, err = s.strg.Auth().User().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, helper.ErrInvalidCursor) || errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetUserList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetUserList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -140,7 +156,10 @@ func (s *userService) GetUserListByRole(ctx context.Context, req *pb.GetUserList
 	s.log.Info("---GetUserListByRole--->", logger.Any("req", req))
 
 	res, err = s.strg.Auth().User().GetListWithRole(ctx, req)
-	if err != nil {
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetUserListByRole--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetUserListByRole--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -157,20 +176,27 @@ func (s *userService) UpdateUser(ctx context.Context, req *pb.UpdateUserReq) (re
 	//	return nil, err
 	//}
 	user := pb.User{
-		Id:         req.GetId(),
-		FirstName:  req.GetFirstName(),
-		LastName:   req.GetLastName(),
-		Gender:     req.GetGender(),
-		Email:      req.GetEmail(),
-		PostCode:   req.GetPostCode(),
-		Address:    req.GetAddress(),
-		Degree:     req.GetDegree(),
-		ProfSphere: req.GetProfSphere(),
-		CountryId:  req.GetCountryId(),
-		CityId:     req.GetCityId(),
-		Username:   req.GetUsername(),
-		Phone:      req.GetPhone(),
-		ExtraPhone: req.GetExtraPhone(),
+		Id:                req.GetId(),
+		FirstName:         req.GetFirstName(),
+		LastName:          req.GetLastName(),
+		Gender:            req.GetGender(),
+		Email:             req.GetEmail(),
+		PostCode:          req.GetPostCode(),
+		Address:           req.GetAddress(),
+		Degree:            req.GetDegree(),
+		ProfSphere:        req.GetProfSphere(),
+		CountryId:         req.GetCountryId(),
+		CityId:            req.GetCityId(),
+		Username:          req.GetUsername(),
+		Phone:             req.GetPhone(),
+		ExtraPhone:        req.GetExtraPhone(),
+		PreferredLanguage: req.GetPreferredLanguage(),
+	}
+
+	if req.GetPreferredLanguage() != "" && !util.IsValidLanguage(req.GetPreferredLanguage()) {
+		err = errors.New("preferred language must be one of en, ru, uz")
+		s.log.Error("!!!UpdateUser--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	if req.GetPassword() != "" && req.GetNewPassword() != "" && req.GetConfirmPassword() != "" {
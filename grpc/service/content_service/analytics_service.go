@@ -0,0 +1,103 @@
+package content_service
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/content_service"
+	"editory_submission/grpc/client"
+	"editory_submission/pkg/logger"
+	"editory_submission/storage"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultStatsPeriod is the range of the platform stats requested without date_from
+const defaultStatsPeriod = 30 * 24 * time.Hour
+
+type analyticsService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	pb.UnimplementedAnalyticsServiceServer
+}
+
+func NewAnalyticsService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, svcs client.ServiceManagerI) *analyticsService {
+	return &analyticsService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: svcs,
+	}
+}
+
+func (s *analyticsService) GetPlatformStats(ctx context.Context, req *pb.GetPlatformStatsReq) (res *pb.PlatformStats, err error) {
+	s.log.Info("---GetPlatformStats--->", logger.Any("req", req))
+
+	granularity := strings.ToUpper(req.GetGranularity())
+	switch granularity {
+	case "":
+		granularity = config.GRANULARITY_DAY
+	case config.GRANULARITY_DAY, config.GRANULARITY_WEEK, config.GRANULARITY_MONTH:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "granularity must be one of DAY, WEEK, MONTH")
+	}
+
+	dateFrom, dateTo, err := parseStatsRange(req.GetDateFrom(), req.GetDateTo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err = s.strg.Analytics().GetPlatformStats(
+		ctx,
+		formatStatsTime(dateFrom),
+		formatStatsTime(dateTo),
+		strings.ToLower(granularity),
+	)
+	if err != nil {
+		s.log.Error("!!!GetPlatformStats--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res.DateFrom = formatStatsTime(dateFrom)
+	res.DateTo = formatStatsTime(dateTo)
+	res.Granularity = granularity
+
+	return res, nil
+}
+
+// parseStatsRange returns the half-open range of the stats, a date_to date includes the whole day
+func parseStatsRange(from, to string) (dateFrom, dateTo time.Time, err error) {
+	dateTo = time.Now().UTC()
+	if to != "" {
+		if dateTo, err = time.Parse(time.DateOnly, to); err == nil {
+			dateTo = dateTo.AddDate(0, 0, 1)
+		} else if dateTo, err = time.Parse(time.RFC3339, to); err != nil {
+			return dateFrom, dateTo, errors.New("date_to must be a date or RFC3339 time")
+		}
+	}
+
+	dateFrom = dateTo.Add(-defaultStatsPeriod)
+	if from != "" {
+		if dateFrom, err = time.Parse(time.DateOnly, from); err != nil {
+			if dateFrom, err = time.Parse(time.RFC3339, from); err != nil {
+				return dateFrom, dateTo, errors.New("date_from must be a date or RFC3339 time")
+			}
+		}
+	}
+
+	if !dateFrom.Before(dateTo) {
+		return dateFrom, dateTo, errors.New("date_from must be before date_to")
+	}
+
+	return dateFrom, dateTo, nil
+}
+
+// formatStatsTime formats the bound of the stats range in UTC, so an offset sent by the client doesn't shift the query
+func formatStatsTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
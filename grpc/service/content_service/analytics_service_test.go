@@ -0,0 +1,95 @@
+package content_service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStatsRange(t *testing.T) {
+	date := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		dateFrom time.Time
+		dateTo   time.Time
+		err      bool
+	}{
+		{
+			name:     "dates include the last day",
+			from:     "2024-03-01",
+			to:       "2024-03-31",
+			dateFrom: date("2024-03-01T00:00:00Z"),
+			dateTo:   date("2024-04-01T00:00:00Z"),
+		},
+		{
+			name:     "timestamps",
+			from:     "2024-03-01T10:00:00Z",
+			to:       "2024-03-02T10:00:00Z",
+			dateFrom: date("2024-03-01T10:00:00Z"),
+			dateTo:   date("2024-03-02T10:00:00Z"),
+		},
+		{
+			name:     "timestamps with an offset",
+			from:     "2024-01-01T00:00:00+05:00",
+			to:       "2024-01-02T00:00:00+05:00",
+			dateFrom: date("2023-12-31T19:00:00Z"),
+			dateTo:   date("2024-01-01T19:00:00Z"),
+		},
+		{
+			name:     "default period before date_to",
+			to:       "2024-03-31",
+			dateFrom: date("2024-04-01T00:00:00Z").Add(-defaultStatsPeriod),
+			dateTo:   date("2024-04-01T00:00:00Z"),
+		},
+		{name: "invalid date_from", from: "march", to: "2024-03-31", err: true},
+		{name: "invalid date_to", from: "2024-03-01", to: "2024-02-30", err: true},
+		{name: "date_from after date_to", from: "2024-04-01", to: "2024-03-01", err: true},
+		{name: "empty range", from: "2024-03-01T10:00:00Z", to: "2024-03-01T10:00:00Z", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dateFrom, dateTo, err := parseStatsRange(tt.from, tt.to)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("parseStatsRange() = %v, %v, want error", dateFrom, dateTo)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("parseStatsRange() unexpected error: %v", err)
+			}
+
+			from, to := formatStatsTime(dateFrom), formatStatsTime(dateTo)
+			if from != tt.dateFrom.Format(time.RFC3339) || to != tt.dateTo.Format(time.RFC3339) {
+				t.Errorf("parseStatsRange() = %s, %s, want %v, %v", from, to, tt.dateFrom, tt.dateTo)
+			}
+		})
+	}
+}
+
+func TestParseStatsRangeDefault(t *testing.T) {
+	before := time.Now().UTC()
+
+	dateFrom, dateTo, err := parseStatsRange("", "")
+	if err != nil {
+		t.Fatalf("parseStatsRange() unexpected error: %v", err)
+	}
+
+	if dateTo.Before(before) || dateTo.After(time.Now().UTC()) {
+		t.Errorf("parseStatsRange() date_to = %v, want now", dateTo)
+	}
+
+	if got := dateTo.Sub(dateFrom); got != defaultStatsPeriod {
+		t.Errorf("parseStatsRange() period = %v, want %v", got, defaultStatsPeriod)
+	}
+}
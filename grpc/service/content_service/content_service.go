@@ -5,9 +5,12 @@ import (
 	"editory_submission/config"
 	pb "editory_submission/genproto/content_service"
 	"editory_submission/grpc/client"
+	"editory_submission/pkg/helper"
 	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
 	"editory_submission/storage/postgres/models"
+	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -33,34 +36,39 @@ func NewContentService(cfg config.Config, log logger.LoggerI, strg storage.Stora
 func (s *contentService) CreateJournal(ctx context.Context, req *pb.CreateJournalReq) (res *pb.Journal, err error) {
 	s.log.Info("---CreateJournal--->", logger.Any("req", req))
 
-	res, err = s.strg.Content().Journal().Create(ctx, req)
-	if err != nil {
-		s.log.Error("!!!CreateJournal--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	for _, val := range req.GetJournalData() {
-		_, err = s.strg.Content().Journal().UpsertJournalData(ctx, &pb.JournalData{
-			JournalId: res.GetId(),
-			Text:      val.GetText(),
-			Type:      val.GetType(),
-			ShortText: val.GetText(),
-		})
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		res, err = strg.Content().Journal().Create(ctx, req)
 		if err != nil {
-			s.log.Error("!!!CreateJournalData--->", logger.Error(err))
-			continue
+			return err
 		}
-	}
 
-	for _, val := range req.GetSubjects() {
-		_, err = s.strg.Content().Journal().UpsertSubject(ctx, &models.UpsertJournalSubjectReq{
-			JournalId: res.GetId(),
-			SubjectId: val.GetId(),
-		})
-		if err != nil {
-			s.log.Error("!!!CreateSubject--->", logger.Error(err))
-			continue
+		for _, val := range req.GetJournalData() {
+			_, err = strg.Content().Journal().UpsertJournalData(ctx, &pb.JournalData{
+				JournalId: res.GetId(),
+				Text:      val.GetText(),
+				Type:      val.GetType(),
+				ShortText: val.GetText(),
+			})
+			if err != nil {
+				return err
+			}
 		}
+
+		for _, val := range req.GetSubjects() {
+			_, err = strg.Content().Journal().UpsertSubject(ctx, &models.UpsertJournalSubjectReq{
+				JournalId: res.GetId(),
+				SubjectId: val.GetId(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("!!!CreateJournal--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return res, nil
@@ -102,7 +110,10 @@ func (s *contentService) GetJournalList(ctx context.Context, req *pb.GetList) (r
 	s.log.Info("---GetJournalList--->", logger.Any("req", req))
 
 	res, err = s.strg.Content().Journal().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetJournalList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetJournalList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -113,43 +124,48 @@ func (s *contentService) GetJournalList(ctx context.Context, req *pb.GetList) (r
 func (s *contentService) UpdateJournal(ctx context.Context, req *pb.Journal) (res *pb.Journal, err error) {
 	s.log.Info("---UpdateJournal--->", logger.Any("req", req))
 
-	res, err = s.strg.Content().Journal().Update(ctx, req)
-	if err != nil {
-		s.log.Error("!!!UpdateJournal--->", logger.Error(err))
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	for _, val := range req.GetJournalData() {
-		_, err = s.strg.Content().Journal().UpsertJournalData(ctx, &pb.JournalData{
-			JournalId: res.GetId(),
-			Text:      val.GetText(),
-			Type:      val.GetType(),
-			ShortText: val.GetShortText(),
-		})
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		res, err = strg.Content().Journal().Update(ctx, req)
 		if err != nil {
-			s.log.Error("!!!CreateJournalData--->", logger.Error(err))
-			continue
+			return err
 		}
-	}
 
-	if len(req.GetSubjects()) > 0 {
-		_, err = s.strg.Content().Journal().DeleteSubject(ctx, &pb.PrimaryKey{
-			Id: res.GetId(),
-		})
-		if err != nil {
-			s.log.Error("!!!DeleteSubject--->", logger.Error(err))
+		for _, val := range req.GetJournalData() {
+			_, err = strg.Content().Journal().UpsertJournalData(ctx, &pb.JournalData{
+				JournalId: res.GetId(),
+				Text:      val.GetText(),
+				Type:      val.GetType(),
+				ShortText: val.GetShortText(),
+			})
+			if err != nil {
+				return err
+			}
 		}
 
-		for _, val := range req.GetSubjects() {
-			_, err = s.strg.Content().Journal().UpsertSubject(ctx, &models.UpsertJournalSubjectReq{
-				JournalId: res.GetId(),
-				SubjectId: val.GetId(),
+		if len(req.GetSubjects()) > 0 {
+			_, err = strg.Content().Journal().DeleteSubject(ctx, &pb.PrimaryKey{
+				Id: res.GetId(),
 			})
 			if err != nil {
-				s.log.Error("!!!CreateSubject--->", logger.Error(err))
-				continue
+				return err
+			}
+
+			for _, val := range req.GetSubjects() {
+				_, err = strg.Content().Journal().UpsertSubject(ctx, &models.UpsertJournalSubjectReq{
+					JournalId: res.GetId(),
+					SubjectId: val.GetId(),
+				})
+				if err != nil {
+					return err
+				}
 			}
 		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("!!!UpdateJournal--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	return res, nil
@@ -176,7 +192,14 @@ func (s *contentService) DeleteJournal(ctx context.Context, req *pb.PrimaryKey)
 func (s *contentService) CreateArticle(ctx context.Context, req *pb.CreateArticleReq) (res *pb.CreateArticleRes, err error) {
 	s.log.Info("---CreateArticle--->", logger.Any("req", req))
 
-	res, err = s.strg.Content().Article().Create(ctx, req)
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		res, err = strg.Content().Article().Create(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return setArticleKeywords(ctx, strg, res.GetId(), req.GetKeywords())
+	})
 	if err != nil {
 		s.log.Error("!!!CreateArticle--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -201,7 +224,10 @@ func (s *contentService) GetArticleList(ctx context.Context, req *pb.GetArticleL
 	s.log.Info("---GetArticleList--->", logger.Any("req", req))
 
 	res, err = s.strg.Content().Article().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, helper.ErrInvalidCursor) || errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetArticleList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetArticleList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -212,7 +238,14 @@ func (s *contentService) GetArticleList(ctx context.Context, req *pb.GetArticleL
 func (s *contentService) UpdateArticle(ctx context.Context, req *pb.UpdateArticleReq) (res *pb.UpdateArticleRes, err error) {
 	s.log.Info("---UpdateArticle--->", logger.Any("req", req))
 
-	res, err = s.strg.Content().Article().Update(ctx, req)
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		res, err = strg.Content().Article().Update(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return setArticleKeywords(ctx, strg, req.GetId(), req.GetKeywords())
+	})
 	if err != nil {
 		s.log.Error("!!!UpdateArticle--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -291,7 +324,10 @@ func (s *contentService) GetEditionList(ctx context.Context, req *pb.GetEditionL
 	s.log.Info("---GetEditionList--->", logger.Any("req", req))
 
 	res, err = s.strg.Content().Edition().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetEditionList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetEditionList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -328,3 +364,17 @@ func (s *contentService) DeleteEdition(ctx context.Context, req *pb.PrimaryKey)
 
 	return res, nil
 }
+
+// setArticleKeywords replaces the article keywords when they are sent, new words are added to keywords
+func setArticleKeywords(ctx context.Context, strg storage.StorageI, articleId string, keywords []string) error {
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	ids, err := strg.Auth().Keyword().GetOrCreate(ctx, keywords)
+	if err != nil {
+		return err
+	}
+
+	return strg.Content().Article().SetKeywords(ctx, articleId, ids)
+}
@@ -5,6 +5,7 @@ import (
 	pb "editory_submission/genproto/content_service"
 	"editory_submission/pkg/logger"
 	"editory_submission/pkg/util"
+	"errors"
 	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -45,7 +46,10 @@ func (s *contentService) GetJournalAuthorList(ctx context.Context, req *pb.GetJo
 	s.log.Info("---GetJournalAuthorList--->", logger.Any("req", req))
 
 	res, err = s.strg.Content().JournalAuthor().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetJournalAuthorList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetJournalAuthorList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -0,0 +1,137 @@
+package content_service
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/content_service"
+	"editory_submission/grpc/client"
+	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type reviewFormService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	pb.UnimplementedReviewFormServiceServer
+}
+
+func NewReviewFormService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, svcs client.ServiceManagerI) *reviewFormService {
+	return &reviewFormService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: svcs,
+	}
+}
+
+func (s *reviewFormService) CreateReviewForm(ctx context.Context, req *pb.CreateReviewFormReq) (res *pb.ReviewForm, err error) {
+	s.log.Info("---CreateReviewForm--->", logger.Any("req", req))
+
+	err = validateReviewFormQuestions(req.GetQuestions())
+	if err != nil {
+		s.log.Error("!!!CreateReviewForm--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err = s.strg.Content().ReviewForm().Create(ctx, req)
+	if err != nil {
+		s.log.Error("!!!CreateReviewForm--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *reviewFormService) GetReviewForm(ctx context.Context, req *pb.GetReviewFormReq) (res *pb.ReviewForm, err error) {
+	s.log.Info("---GetReviewForm--->", logger.Any("req", req))
+
+	if !util.IsValidUUID(req.GetId()) && !util.IsValidUUID(req.GetJournalId()) {
+		return nil, status.Error(codes.InvalidArgument, "id or journal id is required")
+	}
+
+	res, err = s.strg.Content().ReviewForm().Get(ctx, req)
+	if err != nil {
+		s.log.Error("!!!GetReviewForm--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *reviewFormService) GetReviewFormList(ctx context.Context, req *pb.GetReviewFormListReq) (res *pb.GetReviewFormListRes, err error) {
+	s.log.Info("---GetReviewFormList--->", logger.Any("req", req))
+
+	res, err = s.strg.Content().ReviewForm().GetList(ctx, req)
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetReviewFormList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		s.log.Error("!!!GetReviewFormList--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *reviewFormService) UpdateReviewForm(ctx context.Context, req *pb.UpdateReviewFormReq) (res *pb.ReviewForm, err error) {
+	s.log.Info("---UpdateReviewForm--->", logger.Any("req", req))
+
+	err = validateReviewFormQuestions(req.GetQuestions())
+	if err != nil {
+		s.log.Error("!!!UpdateReviewForm--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err = s.strg.Content().ReviewForm().Update(ctx, req)
+	if err != nil {
+		s.log.Error("!!!UpdateReviewForm--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *reviewFormService) DeleteReviewForm(ctx context.Context, req *pb.DeleteReviewFormReq) (res *emptypb.Empty, err error) {
+	s.log.Info("---DeleteReviewForm--->", logger.Any("req", req))
+
+	res = &emptypb.Empty{}
+
+	rowsAffected, err := s.strg.Content().ReviewForm().Delete(ctx, req)
+	if err != nil {
+		s.log.Error("!!!DeleteReviewForm--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
+	}
+
+	return res, nil
+}
+
+func validateReviewFormQuestions(questions []*pb.ReviewFormQuestion) error {
+	for _, val := range questions {
+		if val.GetText() == "" {
+			return errors.New("question text is required")
+		}
+
+		switch val.GetType() {
+		case config.REVIEW_QUESTION_TYPE_RATING:
+			if val.GetScaleMin() >= val.GetScaleMax() {
+				return errors.New("rating question scale_min must be less than scale_max")
+			}
+		case config.REVIEW_QUESTION_TYPE_YES_NO, config.REVIEW_QUESTION_TYPE_TEXT:
+		default:
+			return errors.New("invalid question type: " + val.GetType())
+		}
+	}
+
+	return nil
+}
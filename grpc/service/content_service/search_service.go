@@ -0,0 +1,59 @@
+package content_service
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/content_service"
+	"editory_submission/grpc/client"
+	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type searchService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	pb.UnimplementedSearchServiceServer
+}
+
+func NewSearchService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, svcs client.ServiceManagerI) *searchService {
+	return &searchService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: svcs,
+	}
+}
+
+func (s *searchService) Search(ctx context.Context, req *pb.SearchReq) (res *pb.SearchRes, err error) {
+	s.log.Info("---Search--->", logger.Any("req", req))
+
+	req.Q = strings.TrimSpace(req.GetQ())
+	if req.GetQ() == "" {
+		return nil, status.Error(codes.InvalidArgument, "search query is required")
+	}
+
+	switch req.GetType() {
+	case "", config.SEARCH_TYPE_ARTICLE, config.SEARCH_TYPE_JOURNAL:
+	case config.SEARCH_TYPE_DRAFT:
+		if !util.IsValidUUID(req.GetAuthorId()) && !util.IsValidUUID(req.GetJournalId()) {
+			return nil, status.Error(codes.InvalidArgument, "drafts can be searched only by author or journal")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid search type")
+	}
+
+	res, err = s.strg.Content().Search().Search(ctx, req)
+	if err != nil {
+		s.log.Error("!!!Search--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
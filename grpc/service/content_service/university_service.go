@@ -6,7 +6,9 @@ import (
 	pb "editory_submission/genproto/content_service"
 	"editory_submission/grpc/client"
 	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -57,7 +59,10 @@ func (s *universityService) GetUniversityList(ctx context.Context, req *pb.GetUn
 	s.log.Info("---GetUniversityList--->", logger.Any("req", req))
 
 	res, err = s.strg.Content().University().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetUniversityList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetUniversityList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -5,8 +5,11 @@ import (
 	"editory_submission/config"
 	pb "editory_submission/genproto/notification_service"
 	"editory_submission/grpc/client"
+	"editory_submission/pkg/helper"
 	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -32,6 +35,22 @@ func NewEmailTmpService(cfg config.Config, log logger.LoggerI, strg storage.Stor
 func (s *emailTmpService) CreateEmailTmp(ctx context.Context, req *pb.CreateEmailTmpReq) (res *pb.CreateEmailTmpRes, err error) {
 	s.log.Info("---CreateEmailTmp--->", logger.Any("req", req))
 
+	if req.GetLanguage() == "" {
+		req.Language = s.cfg.DefaultLanguage
+	}
+
+	if !util.IsValidLanguage(req.GetLanguage()) {
+		err = errors.New("language must be one of en, ru, uz")
+		s.log.Error("!!!CreateEmailTmp--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = helper.ValidateEmailTemplate(req.GetType(), req.GetTitle(), req.GetText())
+	if err != nil {
+		s.log.Error("!!!CreateEmailTmp--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	res, err = s.strg.Notification().EmailTemplate().Create(ctx, req)
 	if err != nil {
 		s.log.Error("!!!CreateEmailTmp--->", logger.Error(err))
@@ -57,7 +76,10 @@ func (s *emailTmpService) GetEmailTmpList(ctx context.Context, req *pb.GetEmailT
 	s.log.Info("---GetEmailTmpList--->", logger.Any("req", req))
 
 	res, err = s.strg.Notification().EmailTemplate().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetEmailTmpList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetEmailTmpList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -68,6 +90,21 @@ func (s *emailTmpService) GetEmailTmpList(ctx context.Context, req *pb.GetEmailT
 func (s *emailTmpService) UpdateEmailTmp(ctx context.Context, req *pb.UpdateEmailTmpReq) (res *pb.UpdateEmailTmpRes, err error) {
 	s.log.Info("---UpdateEmailTmp--->", logger.Any("req", req))
 
+	tmp, err := s.strg.Notification().EmailTemplate().Get(ctx, &pb.GetEmailTmpReq{
+		Id: req.GetId(),
+	})
+	if err != nil {
+		s.log.Error("!!!UpdateEmailTmp--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// the type of the template isn't updated
+	err = helper.ValidateEmailTemplate(tmp.GetType(), req.GetTitle(), req.GetText())
+	if err != nil {
+		s.log.Error("!!!UpdateEmailTmp--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	res, err = s.strg.Notification().EmailTemplate().Update(ctx, req)
 	if err != nil {
 		s.log.Error("!!!UpdateEmailTmp--->", logger.Error(err))
@@ -94,3 +131,39 @@ func (s *emailTmpService) DeleteEmailTmp(ctx context.Context, req *pb.DeleteEmai
 
 	return res, nil
 }
+
+// PreviewEmailTmp renders the stored template, or the title and text sent in the request, with the sample data of its type
+func (s *emailTmpService) PreviewEmailTmp(ctx context.Context, req *pb.PreviewEmailTmpReq) (res *pb.PreviewEmailTmpRes, err error) {
+	s.log.Info("---PreviewEmailTmp--->", logger.Any("req", req))
+
+	tmp, err := s.strg.Notification().EmailTemplate().Get(ctx, &pb.GetEmailTmpReq{
+		Id: req.GetId(),
+	})
+	if err != nil {
+		s.log.Error("!!!PreviewEmailTmp--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	title, text := tmp.GetTitle(), tmp.GetText()
+	if req.GetTitle() != "" {
+		title = req.GetTitle()
+	}
+
+	if req.GetText() != "" {
+		text = req.GetText()
+	}
+
+	subject, body, err := helper.PreviewEmailTemplate(tmp.GetType(), title, text)
+	if err != nil {
+		s.log.Error("!!!PreviewEmailTmp--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.PreviewEmailTmpRes{
+		Type:      tmp.GetType(),
+		Language:  tmp.GetLanguage(),
+		Subject:   subject,
+		Text:      body,
+		Variables: helper.EmailTemplateVariables[tmp.GetType()],
+	}, nil
+}
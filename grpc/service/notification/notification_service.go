@@ -11,103 +11,26 @@ import (
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
 	"errors"
-	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"net/url"
-	"time"
-)
-
-const (
-	workers = 2
 )
 
 type notificationService struct {
-	cfg        config.Config
-	log        logger.LoggerI
-	strg       storage.StorageI
-	services   client.ServiceManagerI
-	notifyChan chan string
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
 	pb.UnimplementedNotificationServiceServer
 }
 
 func NewNotificationService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, svcs client.ServiceManagerI) *notificationService {
-	notifyChan := make(chan string, 50)
-
-	for i := 0; i < workers; i++ {
-		go SendMessage(notifyChan, svcs)
-	}
-
 	return &notificationService{
-		cfg:        cfg,
-		log:        log,
-		strg:       strg,
-		services:   svcs,
-		notifyChan: notifyChan,
-	}
-}
-
-func SendMessage(ch <-chan string, services client.ServiceManagerI) {
-	cfg := config.Load()
-	fmt.Println("SendMessage")
-	for v := range ch {
-		fmt.Println("SendMessage")
-		var err error
-		defer func() {
-			if err != nil {
-				ctx := context.Background()
-				_, err = services.NotificationService().UpdateNotification(ctx, &pb.UpdateNotificationReq{
-					Id:     v,
-					Status: config.EMAIL_STATUS_FAILED,
-				})
-				if err != nil {
-					fmt.Printf("!!!SendMessage---> %s", err.Error())
-				}
-			}
-		}()
-
-		ctx, finish := context.WithTimeout(context.Background(), 15*time.Second)
-		defer finish()
-		res, err := services.NotificationService().GetNotification(ctx, &pb.GetNotificationReq{
-			Id: v,
-		})
-		if err != nil {
-			fmt.Printf("!!!SendMessage---> %s", err.Error())
-			continue
-		}
-
-		_, err = services.NotificationService().UpdateNotification(ctx, &pb.UpdateNotificationReq{
-			Id:     v,
-			Status: config.EMAIL_STATUS_PENDING,
-		})
-		if err != nil {
-			fmt.Printf("!!!SendMessage---> %s", err.Error())
-			continue
-		}
-
-		err = helper.GoMessageSend(helper.SendMessageByEmail{
-			From: helper.EmailInfo{
-				Username: cfg.EmailUsername,
-				Password: cfg.EmailPassword,
-			},
-			To:      res.GetEmail(),
-			Subject: res.Subject,
-			Message: res.Text,
-		})
-		if err != nil {
-			fmt.Printf("!!!SendMessage---> %s", err.Error())
-			continue
-		}
-
-		_, err = services.NotificationService().UpdateNotification(ctx, &pb.UpdateNotificationReq{
-			Id:     v,
-			Status: config.EMAIL_STATUS_SENT,
-		})
-		if err != nil {
-			fmt.Printf("!!!SendMessage---> %s", err.Error())
-			continue
-		}
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: svcs,
 	}
 }
 
@@ -139,7 +62,10 @@ func (s *notificationService) GetNotificationList(ctx context.Context, req *pb.G
 	s.log.Info("---GetNotificationList--->", logger.Any("req", req))
 
 	res, err = s.strg.Notification().Notification().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, helper.ErrInvalidCursor) || errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetNotificationList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetNotificationList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -180,11 +106,61 @@ func (s *notificationService) DeleteNotification(ctx context.Context, req *pb.De
 func (s *notificationService) GenerateMailMessage(ctx context.Context, req *pb.GenerateMailMessageReq) (*pb.GenerateMailMessageRes, error) {
 	s.log.Info("---GenerateMailMessage--->", logger.Any("req", req))
 
+	email, subject, mailBody, err := s.makeMailMessage(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.services.NotificationService().CreateNotification(
+		ctx,
+		&pb.CreateNotificationReq{
+			Subject: subject,
+			Text:    mailBody,
+			Email:   email,
+			Status:  config.EMAIL_STATUS_NEW,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the stored notification is delivered by the Outbox
+	return &pb.GenerateMailMessageRes{
+		Id:        res.Id,
+		Subject:   res.Subject,
+		Text:      res.Text,
+		Email:     res.Email,
+		Status:    res.Status,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+	}, nil
+}
+
+// PreviewMailMessage renders the mail like GenerateMailMessage without queueing it
+func (s *notificationService) PreviewMailMessage(ctx context.Context, req *pb.GenerateMailMessageReq) (*pb.GenerateMailMessageRes, error) {
+	s.log.Info("---PreviewMailMessage--->", logger.Any("req", req))
+
+	email, subject, mailBody, err := s.makeMailMessage(ctx, req, true)
+	if err != nil {
+		s.log.Error("!!!PreviewMailMessage--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &pb.GenerateMailMessageRes{
+		Subject: subject,
+		Text:    mailBody,
+		Email:   email,
+	}, nil
+}
+
+// makeMailMessage fills the template of the mail type, or the subject and text sent in the request, with user data.
+// The verification link is made only for the requests with a redirect link, a preview gets a placeholder token instead of a real one
+func (s *notificationService) makeMailMessage(ctx context.Context, req *pb.GenerateMailMessageReq, preview bool) (email, subject, mailBody string, err error) {
 	mailData := make(map[string]string)
 
 	if !util.IsValidUUID(req.UserId) {
-		err := errors.New("invalid user id")
-		return nil, err
+		err = errors.New("invalid user id")
+		return "", "", "", err
 	}
 
 	user, err := s.services.UserService().GetUser(
@@ -194,7 +170,7 @@ func (s *notificationService) GenerateMailMessage(ctx context.Context, req *pb.G
 		},
 	)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 
 	mailData["first_name"] = user.FirstName
@@ -202,85 +178,97 @@ func (s *notificationService) GenerateMailMessage(ctx context.Context, req *pb.G
 	mailData["email"] = user.Email
 	mailData["phone"] = user.Phone
 
-	token, err := s.services.UserService().GenerateEmailVerificationToken(ctx, &auth_service.GenerateEmailVerificationTokenReq{
-		Email:  user.GetEmail(),
-		UserId: user.GetId(),
-	})
-	if err != nil {
-		return nil, err
+	if req.GetRedirectLink() != "" {
+		mailData["link"], err = s.makeVerificationLink(ctx, user, req.GetRedirectLink(), preview)
+		if err != nil {
+			return "", "", "", err
+		}
 	}
 
-	redirectUrl, err := url.Parse(req.RedirectLink)
-	if err != nil {
-		return nil, err
+	for key, val := range req.GetData() {
+		mailData[key] = val
 	}
 
-	values := url.Values{}
-	values.Add("token", token.Token)
-	values.Add("email", token.Email)
+	subject, mailBody = req.GetSubject(), req.GetText()
 
-	redirectUrl.RawQuery = values.Encode()
+	if subject == "" || mailBody == "" {
+		tmp, err := s.findMailTemplate(ctx, req.GetType(), user.GetPreferredLanguage())
+		if err != nil {
+			return "", "", "", err
+		}
 
-	mailData["link"] = redirectUrl.String()
+		if subject == "" {
+			subject = tmp.GetTitle()
+		}
 
-	tmp, err := s.services.EmailTmpService().GetEmailTmpList(
-		ctx,
-		&pb.GetEmailTmpListReq{
-			Type: req.Type,
-		},
-	)
-	if err != nil {
-		return nil, err
+		if mailBody == "" {
+			mailBody = tmp.GetText()
+		}
 	}
 
-	if len(tmp.EmailTmps) == 0 {
-		err := errors.New("cant find suitable mail template")
-		return nil, err
+	subject, mailBody, err = helper.RenderEmailTemplate(req.GetType(), subject, mailBody, mailData)
+	if err != nil {
+		return "", "", "", err
 	}
 
-	subject, mailBody := helper.MakeEmailMessage(
-		mailData,
-		tmp.EmailTmps[0].GetTitle(),
-		tmp.EmailTmps[0].GetText(),
-	)
+	return user.Email, subject, mailBody, nil
+}
 
-	res, err := s.services.NotificationService().CreateNotification(
-		ctx,
-		&pb.CreateNotificationReq{
-			Subject: subject,
-			Text:    mailBody,
-			Email:   user.Email,
-			Status:  config.EMAIL_STATUS_NEW,
-		},
-	)
+// makeVerificationLink adds an email verification token of the user to the redirect link
+func (s *notificationService) makeVerificationLink(ctx context.Context, user *auth_service.User, redirectLink string, preview bool) (string, error) {
+	redirectUrl, err := url.Parse(redirectLink)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	//err = helper.GoMessageSend(helper.SendMessageByEmail{
-	//	From: helper.EmailInfo{
-	//		Username: h.cfg.EmailUsername,
-	//		Password: h.cfg.EmailPassword,
-	//	},
-	//	To:      res.GetEmail(),
-	//	Subject: "Email Verification",
-	//	Message: message,
-	//})
-	//if err != nil {
-	//	return err
-	//}
-
-	fmt.Println("here")
-	s.notifyChan <- res.Id
-	fmt.Println("here")
+	values := url.Values{}
 
-	return &pb.GenerateMailMessageRes{
-		Id:        res.Id,
-		Subject:   res.Subject,
-		Text:      res.Text,
-		Email:     res.Email,
-		Status:    res.Status,
-		CreatedAt: res.CreatedAt,
-		UpdatedAt: res.UpdatedAt,
-	}, nil
+	if preview {
+		values.Add("token", "preview")
+		values.Add("email", user.GetEmail())
+	} else {
+		token, err := s.services.UserService().GenerateEmailVerificationToken(ctx, &auth_service.GenerateEmailVerificationTokenReq{
+			Email:  user.GetEmail(),
+			UserId: user.GetId(),
+		})
+		if err != nil {
+			return "", err
+		}
+
+		values.Add("token", token.Token)
+		values.Add("email", token.Email)
+	}
+
+	redirectUrl.RawQuery = values.Encode()
+
+	return redirectUrl.String(), nil
+}
+
+// findMailTemplate returns the template of the type in the language of the recipient,
+// the template in the default language is used when the type has no variant in it
+func (s *notificationService) findMailTemplate(ctx context.Context, mailType, language string) (*pb.EmailTmp, error) {
+	languages := []string{s.cfg.DefaultLanguage}
+	if util.IsValidLanguage(language) && language != s.cfg.DefaultLanguage {
+		languages = []string{language, s.cfg.DefaultLanguage}
+	}
+
+	for _, val := range languages {
+		tmp, err := s.services.EmailTmpService().GetEmailTmpList(
+			ctx,
+			&pb.GetEmailTmpListReq{
+				Type:     mailType,
+				Language: val,
+				Limit:    1,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(tmp.GetEmailTmps()) > 0 {
+			return tmp.GetEmailTmps()[0], nil
+		}
+	}
+
+	return nil, errors.New("cant find suitable mail template")
 }
@@ -0,0 +1,157 @@
+package notification
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/notification_service"
+	"editory_submission/pkg/helper"
+	"editory_submission/pkg/logger"
+	"editory_submission/storage"
+	"sync"
+	"time"
+)
+
+const (
+	workers = 2
+
+	// sendTimeout limits a single delivery, it is not cancelled on shutdown
+	sendTimeout = 15 * time.Second
+)
+
+// Outbox delivers the notifications stored by GenerateMailMessage.
+// Failed deliveries are retried with exponential backoff until config.NotificationMaxAttempts
+type Outbox struct {
+	cfg    config.Config
+	log    logger.LoggerI
+	strg   storage.StorageI
+	mailer helper.Mailer
+}
+
+func NewOutbox(cfg config.Config, log logger.LoggerI, strg storage.StorageI, mailer helper.Mailer) *Outbox {
+	return &Outbox{
+		cfg:    cfg,
+		log:    log,
+		strg:   strg,
+		mailer: mailer,
+	}
+}
+
+// Run polls the outbox until ctx is done, it returns after the workers finish the mails being sent
+func (o *Outbox) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (o *Outbox) work(ctx context.Context) {
+	ticker := time.NewTicker(config.NotificationPollPeriod)
+	defer ticker.Stop()
+
+	for {
+		// a full batch means more notifications are due, they are claimed without waiting
+		if o.sendBatch(ctx) == config.NotificationBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendBatch sends the claimed notifications and returns how many were claimed
+func (o *Outbox) sendBatch(ctx context.Context) int {
+	if ctx.Err() != nil {
+		return 0
+	}
+
+	notifications, err := o.strg.Notification().Notification().Claim(ctx, config.NotificationBatchSize, config.NotificationLeaseTimeout)
+	if err != nil {
+		o.log.Error("!!!Outbox.Claim--->", logger.Error(err))
+		return 0
+	}
+
+	for i, val := range notifications {
+		if ctx.Err() != nil {
+			o.release(notifications[i:])
+			break
+		}
+
+		o.send(val)
+	}
+
+	return len(notifications)
+}
+
+func (o *Outbox) send(notification *pb.Notification) {
+	ctx, finish := context.WithTimeout(context.Background(), sendTimeout)
+	defer finish()
+
+	err := o.mailer.Send(helper.Mail{
+		To:      notification.GetEmail(),
+		Subject: notification.GetSubject(),
+		Body:    notification.GetText(),
+	})
+	if err == nil {
+		err = o.strg.Notification().Notification().MarkSent(ctx, notification.GetId())
+		if err != nil {
+			o.log.Error("!!!Outbox.MarkSent--->", logger.Error(err))
+		}
+		return
+	}
+
+	o.log.Error("!!!Outbox.Send--->", logger.String("id", notification.GetId()), logger.Error(err))
+
+	if notification.GetAttempts() >= config.NotificationMaxAttempts {
+		err = o.strg.Notification().Notification().MarkDead(ctx, notification.GetId(), err.Error())
+		if err != nil {
+			o.log.Error("!!!Outbox.MarkDead--->", logger.Error(err))
+		}
+		return
+	}
+
+	err = o.strg.Notification().Notification().Retry(ctx, notification.GetId(), err.Error(), retryDelay(notification.GetAttempts()))
+	if err != nil {
+		o.log.Error("!!!Outbox.Retry--->", logger.Error(err))
+	}
+}
+
+// release gives the claimed notifications back on shutdown so that they aren't waiting for the lease timeout
+func (o *Outbox) release(notifications []*pb.Notification) {
+	ctx, finish := context.WithTimeout(context.Background(), sendTimeout)
+	defer finish()
+
+	ids := make([]string, 0, len(notifications))
+	for _, val := range notifications {
+		ids = append(ids, val.GetId())
+	}
+
+	err := o.strg.Notification().Notification().Release(ctx, ids)
+	if err != nil {
+		o.log.Error("!!!Outbox.Release--->", logger.Error(err))
+	}
+}
+
+// retryDelay doubles config.NotificationRetryDelay with every attempt up to config.NotificationMaxRetryDelay
+func retryDelay(attempts int32) time.Duration {
+	delay := config.NotificationRetryDelay
+	for i := int32(1); i < attempts && delay < config.NotificationMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > config.NotificationMaxRetryDelay {
+		delay = config.NotificationMaxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"editory_submission/config"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 0, want: config.NotificationRetryDelay},
+		{attempts: 1, want: config.NotificationRetryDelay},
+		{attempts: 2, want: 2 * config.NotificationRetryDelay},
+		{attempts: 3, want: 4 * config.NotificationRetryDelay},
+		{attempts: 6, want: 32 * config.NotificationRetryDelay},
+		{attempts: 9, want: 256 * config.NotificationRetryDelay},
+		{attempts: 10, want: config.NotificationMaxRetryDelay},
+		{attempts: 1000, want: config.NotificationMaxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"context"
+	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	pb "editory_submission/genproto/notification_service"
+	"editory_submission/grpc/client"
+	"editory_submission/pkg/logger"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"time"
+)
+
+// ScheduleReviewDeadlines periodically reminds reviewers about upcoming deadlines,
+// marks overdue reviews and escalates them to the journal editors. It returns when ctx is done
+func ScheduleReviewDeadlines(ctx context.Context, cfg config.Config, log logger.LoggerI, strg storage.StorageI, svcs client.ServiceManagerI, period time.Duration) {
+	s := NewNotificationService(cfg, log, strg, svcs)
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.checkReviewDeadlines(ctx)
+	}
+}
+
+// checkReviewDeadlines queues the mails in the transaction of the marks, so a review is marked only when its mails are queued
+func (s *notificationService) checkReviewDeadlines(ctx context.Context) {
+	err := s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		reminders, err := strg.Submission().Reviewer().MarkReminded(ctx, s.cfg.ReviewReminderDays)
+		if err != nil {
+			return err
+		}
+
+		for _, val := range reminders {
+			err = s.queueReviewDeadlineMail(ctx, strg, val.ReviewerId, config.REVIEW_REMINDER, val)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("!!!CheckReviewDeadlines--->", logger.Error(err))
+	}
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		overdue, err := strg.Submission().Reviewer().MarkOverdue(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, val := range overdue {
+			err = s.queueReviewDeadlineMail(ctx, strg, val.ReviewerId, config.REVIEW_OVERDUE, val)
+			if err != nil {
+				return err
+			}
+
+			editors, err := strg.Auth().Role().GetList(ctx, &auth_service.GetRoleListReq{
+				JournalId: val.JournalId,
+				RoleTypes: []string{config.EDITOR},
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, editor := range editors.GetRoles() {
+				err = s.queueReviewDeadlineMail(ctx, strg, editor.GetUserId(), config.REVIEW_OVERDUE_EDITOR, val)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("!!!CheckReviewDeadlines--->", logger.Error(err))
+	}
+}
+
+func (s *notificationService) queueReviewDeadlineMail(ctx context.Context, strg storage.StorageI, userId, mailType string, deadline *models.ReviewDeadline) error {
+	email, subject, mailBody, err := s.makeMailMessage(ctx, &pb.GenerateMailMessageReq{
+		UserId: userId,
+		Type:   mailType,
+		Data: map[string]string{
+			"draft_title":    deadline.DraftTitle,
+			"due_at":         deadline.DueAt,
+			"reviewer_name":  deadline.ReviewerName,
+			"reviewer_email": deadline.ReviewerEmail,
+		},
+	}, false)
+	if err != nil {
+		s.log.Error("!!!QueueReviewDeadlineMail--->", logger.Error(err), logger.Any("checker_id", deadline.CheckerId))
+		return err
+	}
+
+	_, err = strg.Notification().Notification().Create(ctx, &pb.CreateNotificationReq{
+		Subject: subject,
+		Text:    mailBody,
+		Email:   email,
+		Status:  config.EMAIL_STATUS_NEW,
+	})
+
+	return err
+}
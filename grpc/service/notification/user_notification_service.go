@@ -0,0 +1,100 @@
+package notification
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/notification_service"
+	"editory_submission/grpc/client"
+	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type userNotificationService struct {
+	cfg      config.Config
+	log      logger.LoggerI
+	strg     storage.StorageI
+	services client.ServiceManagerI
+	pb.UnimplementedUserNotificationServiceServer
+}
+
+func NewUserNotificationService(cfg config.Config, log logger.LoggerI, strg storage.StorageI, svcs client.ServiceManagerI) *userNotificationService {
+	return &userNotificationService{
+		cfg:      cfg,
+		log:      log,
+		strg:     strg,
+		services: svcs,
+	}
+}
+
+func (s *userNotificationService) CreateUserNotification(ctx context.Context, req *pb.CreateUserNotificationReq) (res *pb.UserNotification, err error) {
+	s.log.Info("---CreateUserNotification--->", logger.Any("req", req))
+
+	if !util.IsValidUUID(req.GetUserId()) {
+		err = errors.New("user id is not valid")
+		s.log.Error("!!!CreateUserNotification--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.GetType() == "" {
+		err = errors.New("notification type is required")
+		s.log.Error("!!!CreateUserNotification--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err = s.strg.Notification().UserNotification().Create(ctx, req)
+	if err != nil {
+		s.log.Error("!!!CreateUserNotification--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *userNotificationService) GetUserNotificationList(ctx context.Context, req *pb.GetUserNotificationListReq) (res *pb.GetUserNotificationListRes, err error) {
+	s.log.Info("---GetUserNotificationList--->", logger.Any("req", req))
+
+	res, err = s.strg.Notification().UserNotification().GetList(ctx, req)
+	if err != nil {
+		s.log.Error("!!!GetUserNotificationList--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *userNotificationService) MarkUserNotificationRead(ctx context.Context, req *pb.MarkUserNotificationReadReq) (res *emptypb.Empty, err error) {
+	s.log.Info("---MarkUserNotificationRead--->", logger.Any("req", req))
+
+	res = &emptypb.Empty{}
+
+	rowsAffected, err := s.strg.Notification().UserNotification().MarkRead(ctx, req.GetId(), req.GetUserId())
+	if err != nil {
+		s.log.Error("!!!MarkUserNotificationRead--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if rowsAffected <= 0 {
+		return nil, status.Error(codes.NotFound, "notification not found")
+	}
+
+	return res, nil
+}
+
+func (s *userNotificationService) MarkAllUserNotificationsRead(ctx context.Context, req *pb.MarkAllUserNotificationsReadReq) (res *pb.MarkAllUserNotificationsReadRes, err error) {
+	s.log.Info("---MarkAllUserNotificationsRead--->", logger.Any("req", req))
+
+	rowsAffected, err := s.strg.Notification().UserNotification().MarkAllRead(ctx, req.GetUserId())
+	if err != nil {
+		s.log.Error("!!!MarkAllUserNotificationsRead--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.MarkAllUserNotificationsReadRes{
+		Marked: int32(rowsAffected),
+	}, nil
+}
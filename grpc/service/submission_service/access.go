@@ -0,0 +1,116 @@
+package submission_service
+
+import (
+	"context"
+	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"errors"
+)
+
+var (
+	errNoCheckerAccess = errors.New("no access to the check")
+	errNoDraftAccess   = errors.New("no access to the draft")
+)
+
+// checkerViewerRole works out who the caller is for the checker: the checker itself, the draft author or an editor of the draft journal.
+// editors are cached by the journal id, so a list looks them up once per journal
+func checkerViewerRole(ctx context.Context, strg storage.StorageI, checkerId, checkerType string, draft *pb.Article, userId string, editors map[string]bool) (string, error) {
+	if !util.IsValidUUID(userId) {
+		return "", errNoCheckerAccess
+	}
+
+	switch userId {
+	case checkerId:
+		return checkerType, nil
+	case draft.GetAuthorId():
+		return config.AUTHOR, nil
+	}
+
+	isEditor, ok := editors[draft.GetJournalId()]
+	if !ok {
+		var err error
+
+		isEditor, err = isJournalEditor(ctx, strg, draft.GetJournalId(), userId)
+		if err != nil {
+			return "", err
+		}
+
+		editors[draft.GetJournalId()] = isEditor
+	}
+
+	if !isEditor {
+		return "", errNoCheckerAccess
+	}
+
+	return config.EDITOR, nil
+}
+
+// draftViewerRole works out who the caller is for the draft: its author or an editor of the draft journal
+func draftViewerRole(ctx context.Context, strg storage.StorageI, draft *pb.GetArticleRes, userId string) (string, error) {
+	if !util.IsValidUUID(userId) {
+		return "", errNoDraftAccess
+	}
+
+	if userId == draft.GetAuthorId() {
+		return config.AUTHOR, nil
+	}
+
+	isEditor, err := isJournalEditor(ctx, strg, draft.GetJournalId(), userId)
+	if err != nil {
+		return "", err
+	}
+
+	if !isEditor {
+		return "", errNoDraftAccess
+	}
+
+	return config.EDITOR, nil
+}
+
+// checkCheckerActor allows reviews to be updated only by their reviewer, other checks also by the journal editors
+func (s *checkerService) checkCheckerActor(ctx context.Context, checker *pb.GetArticleCheckerRes, userId string) error {
+	if !util.IsValidUUID(userId) {
+		return errNoCheckerAccess
+	}
+
+	if userId == checker.GetCheckerId() {
+		return nil
+	}
+
+	if checker.GetType() == config.REVIEWER {
+		return errors.New("review belongs to another reviewer")
+	}
+
+	isEditor, err := isJournalEditor(ctx, s.strg, checker.GetArticleIdData().GetJournalId(), userId)
+	if err != nil {
+		return err
+	}
+
+	if !isEditor {
+		return errNoCheckerAccess
+	}
+
+	return nil
+}
+
+func isJournalEditor(ctx context.Context, strg storage.StorageI, journalId, userId string) (bool, error) {
+	// an empty journal would drop the filter and match editors of any journal
+	if !util.IsValidUUID(journalId) {
+		return false, nil
+	}
+
+	roles, err := strg.Auth().Role().GetList(ctx, &auth_service.GetRoleListReq{
+		Limit:     1,
+		UserId:    userId,
+		JournalId: journalId,
+		RoleTypes: []string{config.EDITOR},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(roles.GetRoles()) > 0, nil
+}
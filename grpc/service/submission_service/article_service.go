@@ -3,10 +3,17 @@ package submission_service
 import (
 	"context"
 	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	"editory_submission/genproto/notification_service"
 	pb "editory_submission/genproto/submission_service"
 	"editory_submission/grpc/client"
+	"editory_submission/pkg/helper"
 	"editory_submission/pkg/logger"
+	"editory_submission/pkg/security"
+	"editory_submission/pkg/submission"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -32,7 +39,20 @@ func NewArticleService(cfg config.Config, log logger.LoggerI, strg storage.Stora
 func (s *articleService) CreateArticle(ctx context.Context, req *pb.CreateArticleReq) (res *pb.CreateArticleRes, err error) {
 	s.log.Info("---CreateArticle--->", logger.Any("req", req))
 
-	res, err = s.strg.Submission().Article().Create(ctx, req)
+	err = s.applyCreateTransition(req)
+	if err != nil {
+		s.log.Error("!!!CreateArticle--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		res, err = strg.Submission().Article().Create(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return setDraftKeywords(ctx, strg, res.GetId(), req.GetKeywords())
+	})
 	if err != nil {
 		s.log.Error("!!!CreateArticle--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -57,7 +77,10 @@ func (s *articleService) GetArticleList(ctx context.Context, req *pb.GetArticleL
 	s.log.Info("---GetArticleList--->", logger.Any("req", req))
 
 	res, err = s.strg.Submission().Article().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, helper.ErrInvalidCursor) || errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetArticleList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetArticleList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -68,9 +91,21 @@ func (s *articleService) GetArticleList(ctx context.Context, req *pb.GetArticleL
 func (s *articleService) UpdateArticle(ctx context.Context, req *pb.UpdateArticleReq) (res *pb.UpdateArticleRes, err error) {
 	s.log.Info("---UpdateArticle--->", logger.Any("req", req))
 
-	rowsAffected, err := s.strg.Submission().Article().Update(ctx, req)
+	var (
+		// draft is set when the status changes
+		draft        *pb.GetArticleRes
+		rowsAffected int64
+	)
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		draft, rowsAffected, err = s.updateDraft(ctx, strg, req)
+		return err
+	})
 	if err != nil {
 		s.log.Error("!!!UpdateArticle--->", logger.Error(err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -78,9 +113,162 @@ func (s *articleService) UpdateArticle(ctx context.Context, req *pb.UpdateArticl
 		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
 	}
 
+	if draft != nil && req.GetRoleType() != config.AUTHOR {
+		err = s.publishDraftStatus(ctx, draft, req.GetStatus())
+		if err != nil {
+			s.log.Error("!!!UpdateArticle---> cant publish draft status", logger.Error(err))
+		}
+	}
+
 	return &pb.UpdateArticleRes{}, nil
 }
 
+// updateDraft updates the draft inside the caller's transaction, the draft is returned only when its status changes
+func (s *articleService) updateDraft(ctx context.Context, strg storage.StorageI, req *pb.UpdateArticleReq) (draft *pb.GetArticleRes, rowsAffected int64, err error) {
+	// locks the draft row, so concurrent status changes are validated one after another
+	err = checkLatestRevision(ctx, strg, req.GetId())
+	if err != nil {
+		return nil, 0, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	draft, err = applyUpdateTransition(ctx, strg, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the decision letter is queued with the decision, so neither is saved without the other
+	var letter *notification_service.CreateNotificationReq
+
+	if draft != nil && req.GetRoleType() == config.EDITOR {
+		letter, err = s.makeDecisionNotification(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	rowsAffected, err = strg.Submission().Article().Update(ctx, req)
+	if err != nil || rowsAffected <= 0 {
+		return nil, rowsAffected, err
+	}
+
+	if letter != nil {
+		_, err = strg.Notification().Notification().Create(ctx, letter)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	err = setDraftKeywords(ctx, strg, req.GetId(), req.GetKeywords())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return draft, rowsAffected, nil
+}
+
+// addDraftDetails adds the files and the coauthors to the draft inside the caller's transaction,
+// coauthors the draft already has are skipped
+func (s *articleService) addDraftDetails(ctx context.Context, strg storage.StorageI, draftId string, files []*pb.SubmitDraftFile, coauthors []*pb.SubmitDraftCoAuthor) error {
+	for _, val := range files {
+		_, err := strg.Submission().File().Create(ctx, &pb.AddFilesReq{
+			Url:       val.GetUrl(),
+			Type:      val.GetType(),
+			ArticleId: draftId,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(coauthors) == 0 {
+		return nil
+	}
+
+	existing, err := strg.Submission().CoAuthor().GetList(ctx, &pb.GetCoAuthorsReq{
+		DraftId: draftId,
+	})
+	if err != nil {
+		return err
+	}
+
+	added := make(map[string]bool)
+	for _, val := range existing.GetCoauthors() {
+		added[val.GetUserId()] = true
+	}
+
+	for _, val := range coauthors {
+		user, err := s.getOrCreateCoAuthor(ctx, strg, val)
+		if err != nil {
+			return err
+		}
+
+		if added[user.GetId()] {
+			continue
+		}
+
+		_, err = strg.Submission().CoAuthor().Create(ctx, &pb.AddCoAuthorReq{
+			ArticleId: draftId,
+			UserId:    user.GetId(),
+		})
+		if err != nil {
+			return err
+		}
+
+		added[user.GetId()] = true
+	}
+
+	return nil
+}
+
+// validateCoAuthors checks the coauthors before any of them is registered
+func validateCoAuthors(coauthors []*pb.SubmitDraftCoAuthor) error {
+	for _, val := range coauthors {
+		if !util.IsValidEmail(val.GetEmail()) {
+			return errors.New("coauthor email is not valid")
+		}
+	}
+
+	return nil
+}
+
+// applyUpdateTransition validates the status change of the draft locked by the caller and sets the fields following it.
+// The draft is returned only when its status changes
+func applyUpdateTransition(ctx context.Context, strg storage.StorageI, req *pb.UpdateArticleReq) (*pb.GetArticleRes, error) {
+	if req.GetStatus() == "" {
+		return nil, nil
+	}
+
+	draft, err := strg.Submission().Article().Get(ctx, &pb.GetArticleReq{
+		Id: req.GetId(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if draft.GetStatus() == req.GetStatus() {
+		return nil, nil
+	}
+
+	t, err := submission.Transit(draft.GetStatus(), req.GetStatus(), req.GetRoleType())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if t.Step != "" {
+		req.Step = t.Step
+	}
+
+	if t.EditorStatus != "" {
+		req.EditorStatus = t.EditorStatus
+	}
+
+	if t.ReviewerStatus != "" {
+		req.ReviewerStatus = t.ReviewerStatus
+	}
+
+	return draft, nil
+}
+
 func (s *articleService) DeleteArticle(ctx context.Context, req *pb.DeleteArticleReq) (res *emptypb.Empty, err error) {
 	s.log.Info("---DeleteArticle--->", logger.Any("req", req))
 
@@ -98,3 +286,377 @@ func (s *articleService) DeleteArticle(ctx context.Context, req *pb.DeleteArticl
 
 	return res, nil
 }
+
+func (s *articleService) SubmitDraft(ctx context.Context, req *pb.SubmitDraftReq) (res *pb.GetArticleRes, err error) {
+	s.log.Info("---SubmitDraft--->", logger.Any("req", req))
+
+	draft := req.GetDraft()
+	if draft == nil {
+		err = errors.New("draft is required")
+		s.log.Error("!!!SubmitDraft--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.applyCreateTransition(draft)
+	if err != nil {
+		s.log.Error("!!!SubmitDraft--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	err = validateCoAuthors(req.GetCoauthors())
+	if err != nil {
+		s.log.Error("!!!SubmitDraft--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	articleId := ""
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		article, err := strg.Submission().Article().Create(ctx, draft)
+		if err != nil {
+			return err
+		}
+		articleId = article.GetId()
+
+		err = setDraftKeywords(ctx, strg, articleId, draft.GetKeywords())
+		if err != nil {
+			return err
+		}
+
+		return s.addDraftDetails(ctx, strg, articleId, req.GetFiles(), req.GetCoauthors())
+	})
+	if err != nil {
+		s.log.Error("!!!SubmitDraft--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err = s.getDraftWithDetails(ctx, articleId)
+	if err != nil {
+		s.log.Error("!!!SubmitDraft--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *articleService) UpdateDraft(ctx context.Context, req *pb.UpdateDraftReq) (res *pb.GetArticleRes, err error) {
+	s.log.Info("---UpdateDraft--->", logger.Any("req", req))
+
+	draft := req.GetDraft()
+	if draft == nil {
+		err = errors.New("draft is required")
+		s.log.Error("!!!UpdateDraft--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = validateCoAuthors(req.GetCoauthors())
+	if err != nil {
+		s.log.Error("!!!UpdateDraft--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// changed is set when the status changes
+	var changed *pb.GetArticleRes
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		var rowsAffected int64
+
+		changed, rowsAffected, err = s.updateDraft(ctx, strg, draft)
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected <= 0 {
+			return status.Error(codes.NotFound, "draft not found")
+		}
+
+		return s.addDraftDetails(ctx, strg, draft.GetId(), req.GetFiles(), req.GetCoauthors())
+	})
+	if err != nil {
+		s.log.Error("!!!UpdateDraft--->", logger.Error(err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if changed != nil && draft.GetRoleType() != config.AUTHOR {
+		err = s.publishDraftStatus(ctx, changed, draft.GetStatus())
+		if err != nil {
+			s.log.Error("!!!UpdateDraft---> cant publish draft status", logger.Error(err))
+		}
+	}
+
+	res, err = s.getDraftWithDetails(ctx, draft.GetId())
+	if err != nil {
+		s.log.Error("!!!UpdateDraft--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *articleService) SubmitRevision(ctx context.Context, req *pb.SubmitRevisionReq) (res *pb.GetArticleRes, err error) {
+	s.log.Info("---SubmitRevision--->", logger.Any("req", req))
+
+	draft, err := s.strg.Submission().Article().Get(ctx, &pb.GetArticleReq{
+		Id: req.GetDraftId(),
+	})
+	if err != nil {
+		s.log.Error("!!!SubmitRevision--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if draft.GetAuthorId() != req.GetAuthorId() {
+		err = errors.New("only the author can submit a revision of the draft")
+		s.log.Error("!!!SubmitRevision--->", logger.Error(err))
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	t, err := submission.SubmitRevision(draft.GetStatus(), config.AUTHOR)
+	if err != nil {
+		s.log.Error("!!!SubmitRevision--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	coauthors, err := s.strg.Submission().CoAuthor().GetList(ctx, &pb.GetCoAuthorsReq{
+		DraftId: draft.GetId(),
+	})
+	if err != nil {
+		s.log.Error("!!!SubmitRevision--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	articleId := ""
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		err := checkLatestRevision(ctx, strg, draft.GetId())
+		if err != nil {
+			return err
+		}
+
+		article, err := strg.Submission().Article().Create(ctx, &pb.CreateArticleReq{
+			JournalId:    draft.GetJournalId(),
+			Type:         draft.GetType(),
+			Title:        draft.GetTitle(),
+			AuthorId:     draft.GetAuthorId(),
+			Description:  draft.GetDescription(),
+			Status:       t.To,
+			Step:         t.Step,
+			GroupId:      draft.GetGroupId(),
+			Conflict:     draft.GetConflict(),
+			Availability: draft.GetAvailability(),
+			Funding:      draft.GetFunding(),
+			DraftStep:    draft.GetDraftStep(),
+			Revision:     draft.GetRevision() + 1,
+		})
+		if err != nil {
+			return err
+		}
+		articleId = article.GetId()
+
+		keywordIds := []string{}
+		for _, val := range draft.GetKeywords() {
+			keywordIds = append(keywordIds, val.GetId())
+		}
+
+		err = strg.Submission().Article().SetKeywords(ctx, articleId, keywordIds)
+		if err != nil {
+			return err
+		}
+
+		for _, val := range coauthors.GetCoauthors() {
+			_, err = strg.Submission().CoAuthor().Create(ctx, &pb.AddCoAuthorReq{
+				ArticleId: articleId,
+				UserId:    val.GetUserId(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = strg.Submission().DraftEvent().Create(ctx, &pb.DraftEvent{
+			DraftId:   articleId,
+			ActorId:   req.GetAuthorId(),
+			Type:      config.DRAFT_EVENT_REVISION_CREATE,
+			OldStatus: draft.GetStatus(),
+			NewStatus: article.GetStatus(),
+			OldStep:   draft.GetStep(),
+			NewStep:   article.GetStep(),
+			Comment:   req.GetComment(),
+		})
+
+		return err
+	})
+	if errors.Is(err, submission.ErrRevisionReadOnly) {
+		s.log.Error("!!!SubmitRevision--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		s.log.Error("!!!SubmitRevision--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err = s.getDraftWithDetails(ctx, articleId)
+	if err != nil {
+		s.log.Error("!!!SubmitRevision--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+func (s *articleService) GetDraftHistory(ctx context.Context, req *pb.GetDraftHistoryReq) (res *pb.GetDraftHistoryRes, err error) {
+	s.log.Info("---GetDraftHistory--->", logger.Any("req", req))
+
+	draft, reviewMode, err := draftReviewMode(ctx, s.strg, req.GetDraftId())
+	if err != nil {
+		s.log.Error("!!!GetDraftHistory--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	roleType, err := draftViewerRole(ctx, s.strg, draft, req.GetViewerId())
+	if err != nil {
+		s.log.Error("!!!GetDraftHistory--->", logger.Error(err))
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	res, err = s.strg.Submission().DraftEvent().GetList(ctx, req)
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetDraftHistory--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		s.log.Error("!!!GetDraftHistory--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if hidesReviewer(reviewMode, roleType) {
+		maskDraftHistoryReviewers(res, draft.GetAuthorId())
+	}
+
+	return res, nil
+}
+
+// getDraftWithDetails returns the draft with its files and coauthors
+func (s *articleService) getDraftWithDetails(ctx context.Context, id string) (res *pb.GetArticleRes, err error) {
+	res, err = s.strg.Submission().Article().Get(ctx, &pb.GetArticleReq{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := s.strg.Submission().File().GetList(ctx, &pb.GetFilesReq{
+		ArticleId: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Files = files.GetFiles()
+
+	coauthors, err := s.strg.Submission().CoAuthor().GetList(ctx, &pb.GetCoAuthorsReq{
+		DraftId: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.Coauthors = coauthors.GetCoauthors()
+
+	return res, nil
+}
+
+// setDraftKeywords replaces the draft keywords when they are sent, new words are added to keywords
+func setDraftKeywords(ctx context.Context, strg storage.StorageI, draftId string, keywords []string) error {
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	ids, err := strg.Auth().Keyword().GetOrCreate(ctx, keywords)
+	if err != nil {
+		return err
+	}
+
+	return strg.Submission().Article().SetKeywords(ctx, draftId, ids)
+}
+
+// checkLatestRevision returns ErrRevisionReadOnly if the draft has a newer revision.
+// Missing draft is left to the caller's own query
+func checkLatestRevision(ctx context.Context, strg storage.StorageI, draftId string) error {
+	isLatest, err := strg.Submission().Article().IsLatestRevision(ctx, draftId)
+	if err != nil {
+		if util.IsErrNoRows(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !isLatest {
+		return submission.ErrRevisionReadOnly
+	}
+
+	return nil
+}
+
+// applyCreateTransition validates the status a draft is created with and sets the step following it
+func (s *articleService) applyCreateTransition(req *pb.CreateArticleReq) error {
+	if req.GetStatus() == "" {
+		req.Status = config.ARTICLE_STATUS_DRAFT
+	}
+
+	t, err := submission.Transit("", req.GetStatus(), req.GetRoleType())
+	if err != nil {
+		return err
+	}
+
+	req.Step = t.Step
+
+	return nil
+}
+
+// getOrCreateCoAuthor finds the coauthor by email or registers a new user with AUTHOR role
+func (s *articleService) getOrCreateCoAuthor(ctx context.Context, strg storage.StorageI, req *pb.SubmitDraftCoAuthor) (res *auth_service.User, err error) {
+	res, err = strg.Auth().User().Get(ctx, &auth_service.GetUserReq{
+		Email: req.GetEmail(),
+	})
+	if err != nil {
+		if !util.IsErrNoRows(err) {
+			return nil, err
+		}
+
+		password, err := security.HashPassword(config.DEFAULT_PASSWORD)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err = strg.Auth().User().Create(ctx, &auth_service.User{
+			FirstName:    req.GetFirstName(),
+			LastName:     req.GetLastName(),
+			Email:        req.GetEmail(),
+			UniversityId: req.GetUniversityId(),
+			CountryId:    req.GetCountryId(),
+			Password:     password,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	roles, err := strg.Auth().Role().GetList(ctx, &auth_service.GetRoleListReq{
+		UserId:    res.GetId(),
+		RoleTypes: []string{config.AUTHOR},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roles.GetRoles()) == 0 {
+		_, err = strg.Auth().Role().Create(ctx, &auth_service.Role{
+			UserId:   res.GetId(),
+			RoleType: config.AUTHOR,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,171 @@
+package submission_service
+
+import (
+	"context"
+	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	"editory_submission/genproto/content_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/logger"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var errTestRollback = errors.New("test rollback")
+
+// withTestStorage runs fn in a transaction of the database from POSTGRES_HOST which is always rolled back,
+// the test is skipped when the database isn't set or available
+func withTestStorage(t *testing.T, fn func(ctx context.Context, strg storage.StorageI)) {
+	if os.Getenv("POSTGRES_HOST") == "" {
+		t.Skip("set POSTGRES_HOST to run the database tests")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	strg, err := postgres.NewPostgres(ctx, config.Load())
+	if err != nil {
+		t.Skipf("postgres is not available: %v", err)
+	}
+	defer strg.CloseDB()
+
+	err = strg.WithTx(ctx, func(tx storage.StorageI) error {
+		fn(ctx, tx)
+		return errTestRollback
+	})
+	if !errors.Is(err, errTestRollback) {
+		t.Fatal(err)
+	}
+}
+
+// createTestDraft creates an author, a journal and a DRAFT of the author in the journal
+func createTestDraft(ctx context.Context, t *testing.T, strg storage.StorageI) *pb.CreateArticleRes {
+	author, err := strg.Auth().User().Create(ctx, &auth_service.User{
+		FirstName: "Test",
+		LastName:  "Author",
+		Email:     uuid.NewString() + "@example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	journal, err := strg.Content().Journal().Create(ctx, &content_service.CreateJournalReq{
+		Title:    "Test journal",
+		Isbn:     uuid.NewString(),
+		AuthorId: author.GetId(),
+		Status:   "ACTIVE",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	draft, err := strg.Submission().Article().Create(ctx, &pb.CreateArticleReq{
+		JournalId: journal.GetId(),
+		Title:     "Original title",
+		AuthorId:  author.GetId(),
+		Status:    config.ARTICLE_STATUS_DRAFT,
+		Step:      config.DRAFT_STEP_AUTHOR,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return draft
+}
+
+func TestUpdateDraftIsAtomic(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     []*pb.SubmitDraftFile
+		coauthors []*pb.SubmitDraftCoAuthor
+		wantErr   bool
+		title     string
+		fileCount int
+		coCount   int
+	}{
+		{
+			name: "saves the draft with its files and coauthors",
+			files: []*pb.SubmitDraftFile{
+				{Url: "https://example.com/manuscript.pdf", Type: "MANUSCRIPT"},
+			},
+			coauthors: []*pb.SubmitDraftCoAuthor{
+				{Email: uuid.NewString() + "@example.com", FirstName: "Co", LastName: "Author"},
+			},
+			title:     "Updated title",
+			fileCount: 1,
+			coCount:   1,
+		},
+		{
+			name: "invalid file leaves the draft as it was",
+			files: []*pb.SubmitDraftFile{
+				{Url: "https://example.com/manuscript.pdf", Type: "MANUSCRIPT"},
+				{Url: "https://example.com/unknown.bin", Type: "NOT_A_FILE_TYPE"},
+			},
+			coauthors: []*pb.SubmitDraftCoAuthor{
+				{Email: uuid.NewString() + "@example.com", FirstName: "Co", LastName: "Author"},
+			},
+			wantErr: true,
+			title:   "Original title",
+		},
+		{
+			name: "invalid coauthor leaves the draft as it was",
+			coauthors: []*pb.SubmitDraftCoAuthor{
+				{Email: "not an email"},
+			},
+			wantErr: true,
+			title:   "Original title",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withTestStorage(t, func(ctx context.Context, strg storage.StorageI) {
+				s := &articleService{
+					cfg:  config.Load(),
+					log:  logger.NewLogger("test", logger.LevelError),
+					strg: strg,
+				}
+
+				draft := createTestDraft(ctx, t, strg)
+
+				_, err := s.UpdateDraft(ctx, &pb.UpdateDraftReq{
+					Draft: &pb.UpdateArticleReq{
+						Id:       draft.GetId(),
+						Title:    "Updated title",
+						AuthorId: draft.GetAuthorId(),
+						ActorId:  draft.GetAuthorId(),
+						RoleType: config.AUTHOR,
+					},
+					Files:     tt.files,
+					Coauthors: tt.coauthors,
+				})
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("UpdateDraft() error = %v, want error %v", err, tt.wantErr)
+				}
+
+				got, err := s.getDraftWithDetails(ctx, draft.GetId())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got.GetTitle() != tt.title {
+					t.Errorf("title = %q, want %q", got.GetTitle(), tt.title)
+				}
+
+				if len(got.GetFiles()) != tt.fileCount {
+					t.Errorf("files = %d, want %d", len(got.GetFiles()), tt.fileCount)
+				}
+
+				if len(got.GetCoauthors()) != tt.coCount {
+					t.Errorf("coauthors = %d, want %d", len(got.GetCoauthors()), tt.coCount)
+				}
+			})
+		})
+	}
+}
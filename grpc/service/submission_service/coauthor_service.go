@@ -2,6 +2,7 @@ package submission_service
 
 import (
 	"context"
+	"editory_submission/config"
 	"editory_submission/genproto/submission_service"
 	"editory_submission/pkg/logger"
 	"google.golang.org/grpc/codes"
@@ -12,6 +13,12 @@ import (
 func (s *articleService) AddCoAuthor(ctx context.Context, req *submission_service.AddCoAuthorReq) (res *submission_service.AddCoAuthorRes, err error) {
 	s.log.Info("---AddCoAuthor--->", logger.Any("req", req))
 
+	err = checkLatestRevision(ctx, s.strg, req.GetArticleId())
+	if err != nil {
+		s.log.Error("!!!AddCoAuthor--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	res, err = s.strg.Submission().CoAuthor().Create(ctx, req)
 	if err != nil {
 		s.log.Error("!!!AddCoAuthor--->", logger.Error(err))
@@ -30,6 +37,18 @@ func (s *articleService) GetCoAuthors(ctx context.Context, req *submission_servi
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if req.GetRoleType() == config.REVIEWER {
+		_, reviewMode, err := draftReviewMode(ctx, s.strg, req.GetDraftId())
+		if err != nil {
+			s.log.Error("!!!GetCoAuthors--->", logger.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if hidesAuthor(reviewMode, req.GetRoleType()) {
+			res.Coauthors = maskCoAuthors(res.GetCoauthors())
+		}
+	}
+
 	return res, nil
 }
 
@@ -0,0 +1,166 @@
+package submission_service
+
+import (
+	"context"
+	"editory_submission/config"
+	"editory_submission/genproto/notification_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/logger"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"html"
+	"math"
+	"strings"
+)
+
+// decisionLetterTypes are the decision letter templates of the editor decisions
+var decisionLetterTypes = map[string]string{
+	config.ARTICLE_STATUS_CONFIRMED:           config.DECISION_ACCEPT,
+	config.ARTICLE_STATUS_DENIED:              config.DECISION_REJECT,
+	config.ARTICLE_STATUS_BACK_FOR_CORRECTION: config.DECISION_REVISE,
+}
+
+// submittedReviewStatus are the reviewer statuses which have a review to share with the author
+var submittedReviewStatus = map[string]bool{
+	config.ARTICLE_REVIEWER_STATUS_APPROVED:            true,
+	config.ARTICLE_REVIEWER_STATUS_REJECTED:            true,
+	config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION: true,
+}
+
+func (s *articleService) PreviewDecisionLetter(ctx context.Context, req *pb.PreviewDecisionLetterReq) (res *pb.DecisionLetter, err error) {
+	s.log.Info("---PreviewDecisionLetter--->", logger.Any("req", req))
+
+	mailType, ok := decisionLetterTypes[req.GetStatus()]
+	if !ok {
+		err = errors.New("status has no decision letter: " + req.GetStatus())
+		s.log.Error("!!!PreviewDecisionLetter--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	draft, reviewMode, err := draftReviewMode(ctx, s.strg, req.GetDraftId())
+	if err != nil {
+		s.log.Error("!!!PreviewDecisionLetter--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	mail, err := s.makeDecisionLetter(ctx, draft, reviewMode, mailType, req.GetComment())
+	if err != nil {
+		s.log.Error("!!!PreviewDecisionLetter--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	letter, err := s.services.NotificationService().PreviewMailMessage(ctx, mail)
+	if err != nil {
+		s.log.Error("!!!PreviewDecisionLetter--->", logger.Error(err))
+		return nil, err
+	}
+
+	return &pb.DecisionLetter{
+		Type:    mailType,
+		Email:   letter.GetEmail(),
+		Subject: letter.GetSubject(),
+		Text:    letter.GetText(),
+	}, nil
+}
+
+// makeDecisionNotification renders the letter of the editor decision to the draft author, so it can be queued with the decision.
+// The edited subject and text are the final letter and aren't rendered again, the template is used for the empty ones
+func (s *articleService) makeDecisionNotification(ctx context.Context, req *pb.UpdateArticleReq) (*notification_service.CreateNotificationReq, error) {
+	mailType, ok := decisionLetterTypes[req.GetStatus()]
+	if !ok {
+		return nil, nil
+	}
+
+	draft, reviewMode, err := draftReviewMode(ctx, s.strg, req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	mail, err := s.makeDecisionLetter(ctx, draft, reviewMode, mailType, req.GetComment())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	letter, err := s.services.NotificationService().PreviewMailMessage(ctx, mail)
+	if err != nil {
+		return nil, err
+	}
+
+	notification := &notification_service.CreateNotificationReq{
+		Subject: letter.GetSubject(),
+		Text:    letter.GetText(),
+		Email:   letter.GetEmail(),
+		Status:  config.EMAIL_STATUS_NEW,
+	}
+
+	if req.GetLetterSubject() != "" {
+		notification.Subject = req.GetLetterSubject()
+	}
+
+	if req.GetLetterText() != "" {
+		notification.Text = req.GetLetterText()
+	}
+
+	return notification, nil
+}
+
+func (s *articleService) makeDecisionLetter(ctx context.Context, draft *pb.GetArticleRes, reviewMode, mailType, comment string) (*notification_service.GenerateMailMessageReq, error) {
+	reviews, err := s.strg.Submission().Reviewer().GetList(ctx, &pb.GetArticleCheckerListReq{
+		ArticleId: draft.GetId(),
+		Type:      config.REVIEWER,
+		Limit:     math.MaxInt32,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var comments strings.Builder
+	number := 0
+
+	for _, val := range reviews.GetArticleCheckers() {
+		if !submittedReviewStatus[val.GetStatus()] {
+			continue
+		}
+
+		review, err := s.strg.Submission().Reviewer().Get(ctx, &pb.GetArticleCheckerReq{
+			Id: val.GetId(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		number++
+
+		// reviewer names are shared only in open review
+		reviewer := fmt.Sprintf("Reviewer %d", number)
+		if !hidesReviewer(reviewMode, config.AUTHOR) {
+			reviewer = strings.TrimSpace(review.GetCheckerIdData().GetFirstName() + " " + review.GetCheckerIdData().GetLastName())
+		}
+
+		comments.WriteString("<p><b>" + html.EscapeString(reviewer) + "</b></p>")
+
+		if review.GetComment() != "" {
+			comments.WriteString("<p>" + html.EscapeString(review.GetComment()) + "</p>")
+		}
+
+		if len(review.GetComments()) > 0 {
+			comments.WriteString("<ul>")
+			for _, fileComment := range review.GetComments() {
+				comments.WriteString("<li>" + html.EscapeString(fileComment.GetComment()) + "</li>")
+			}
+			comments.WriteString("</ul>")
+		}
+	}
+
+	return &notification_service.GenerateMailMessageReq{
+		UserId: draft.GetAuthorId(),
+		Type:   mailType,
+		Data: map[string]string{
+			"draft_title":       draft.GetTitle(),
+			"editor_comment":    comment,
+			"reviewer_comments": comments.String(),
+		},
+	}, nil
+}
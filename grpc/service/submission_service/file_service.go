@@ -2,6 +2,7 @@ package submission_service
 
 import (
 	"context"
+	"editory_submission/config"
 	"editory_submission/genproto/submission_service"
 	"editory_submission/pkg/logger"
 	"google.golang.org/grpc/codes"
@@ -12,6 +13,12 @@ import (
 func (s *articleService) AddFiles(ctx context.Context, req *submission_service.AddFilesReq) (res *submission_service.AddFilesRes, err error) {
 	s.log.Info("---AddFiles--->", logger.Any("req", req))
 
+	err = checkLatestRevision(ctx, s.strg, req.GetArticleId())
+	if err != nil {
+		s.log.Error("!!!AddFiles--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	res, err = s.strg.Submission().File().Create(ctx, req)
 	if err != nil {
 		s.log.Error("!!!AddFiles--->", logger.Error(err))
@@ -30,6 +37,19 @@ func (s *articleService) GetFiles(ctx context.Context, req *submission_service.G
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if req.GetRoleType() == config.REVIEWER {
+		_, reviewMode, err := draftReviewMode(ctx, s.strg, req.GetArticleId())
+		if err != nil {
+			s.log.Error("!!!GetFiles--->", logger.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if hidesAuthor(reviewMode, req.GetRoleType()) {
+			res.Files = maskFiles(res.GetFiles())
+			res.Count = int32(len(res.GetFiles()))
+		}
+	}
+
 	return res, nil
 }
 
@@ -0,0 +1,218 @@
+package submission_service
+
+import (
+	"context"
+	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	"editory_submission/genproto/notification_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/helper"
+	"editory_submission/pkg/logger"
+	"editory_submission/pkg/security"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// invitationAnswers maps the signed link action to the invitation and checker statuses it leads to
+var invitationAnswers = map[string][2]string{
+	config.INVITATION_ACTION_ACCEPT:  {config.INVITATION_STATUS_ACCEPTED, config.ARTICLE_REVIEWER_STATUS_PENDING},
+	config.INVITATION_ACTION_DECLINE: {config.INVITATION_STATUS_DECLINED, config.ARTICLE_REVIEWER_STATUS_DECLINED},
+}
+
+func (s *checkerService) RespondReviewerInvitation(ctx context.Context, req *pb.RespondReviewerInvitationReq) (res *pb.ReviewerInvitation, err error) {
+	s.log.Info("---RespondReviewerInvitation--->", logger.Any("req", req))
+
+	claims, err := security.ExtractClaims(req.GetToken(), s.cfg.SecretKey)
+	if err != nil {
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, _ := claims["id"].(string)
+	action, _ := claims["action"].(string)
+
+	answer, ok := invitationAnswers[action]
+	if !ok {
+		err = errors.New("invalid invitation action")
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accepted := action == config.INVITATION_ACTION_ACCEPT
+
+	if accepted && !req.GetConflictDeclared() {
+		err = errors.New("conflict of interest declaration is required to accept the invitation")
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if accepted && req.GetHasConflict() && strings.TrimSpace(req.GetConflictStatement()) == "" {
+		err = errors.New("conflict of interest statement is required")
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	invitation, err := s.strg.Submission().Invitation().Get(ctx, id)
+	if err != nil {
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// the invited reviewer is the actor of the answer
+	checker, err := s.strg.Submission().Reviewer().Get(ctx, &pb.GetArticleCheckerReq{
+		Id: invitation.GetDraftCheckerId(),
+	})
+	if err != nil {
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		rowsAffected, err := strg.Submission().Invitation().Respond(ctx, id, answer[0])
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected <= 0 {
+			return status.Error(codes.FailedPrecondition, "invitation is already answered or expired")
+		}
+
+		_, err = strg.Submission().Reviewer().Update(ctx, &pb.UpdateArticleCheckerReq{
+			Id:      invitation.GetDraftCheckerId(),
+			Status:  answer[1],
+			ActorId: checker.GetCheckerId(),
+		})
+		if err != nil || !accepted {
+			return err
+		}
+
+		_, err = strg.Submission().Reviewer().DeclareConflict(ctx, invitation.GetDraftCheckerId(), req.GetHasConflict(), req.GetConflictStatement())
+
+		return err
+	})
+	if err != nil {
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err = s.strg.Submission().Invitation().Get(ctx, id)
+	if err != nil {
+		s.log.Error("!!!RespondReviewerInvitation--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return res, nil
+}
+
+// makeInvitationNotification renders the mail with the reviewer accept and decline links signed for the invitation,
+// so it can be queued with the assignment. The reviewer and the template are read through strg, because the reviewer
+// may be registered by the same transaction
+func (s *checkerService) makeInvitationNotification(ctx context.Context, strg storage.StorageI, article *pb.GetArticleRes, checkerId, invitationId string, expiresAt time.Time) (*notification_service.CreateNotificationReq, error) {
+	reviewer, err := strg.Auth().User().Get(ctx, &auth_service.GetUserReq{
+		Id: checkerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{
+		"first_name":  reviewer.GetFirstName(),
+		"last_name":   reviewer.GetLastName(),
+		"email":       reviewer.GetEmail(),
+		"phone":       reviewer.GetPhone(),
+		"draft_title": article.GetTitle(),
+		"expires_at":  expiresAt.Format(config.DatabaseTimeLayout),
+	}
+
+	for action, key := range map[string]string{
+		config.INVITATION_ACTION_ACCEPT:  "accept_link",
+		config.INVITATION_ACTION_DECLINE: "decline_link",
+	} {
+		token, err := security.GenerateJWT(map[string]interface{}{
+			"id":     invitationId,
+			"action": action,
+		}, time.Until(expiresAt), s.cfg.SecretKey)
+		if err != nil {
+			return nil, err
+		}
+
+		link, err := url.Parse(s.cfg.ReviewerInvitationURL)
+		if err != nil {
+			return nil, err
+		}
+
+		values := url.Values{}
+		values.Add("token", token)
+		link.RawQuery = values.Encode()
+
+		data[key] = link.String()
+	}
+
+	// the template of the reviewer language, or of the default one
+	languages := []string{s.cfg.DefaultLanguage}
+	if util.IsValidLanguage(reviewer.GetPreferredLanguage()) && reviewer.GetPreferredLanguage() != s.cfg.DefaultLanguage {
+		languages = []string{reviewer.GetPreferredLanguage(), s.cfg.DefaultLanguage}
+	}
+
+	for _, language := range languages {
+		tmp, err := strg.Notification().EmailTemplate().GetList(ctx, &notification_service.GetEmailTmpListReq{
+			Type:     config.NEW_ARTICLE_TO_REVIEW,
+			Language: language,
+			Limit:    1,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(tmp.GetEmailTmps()) == 0 {
+			continue
+		}
+
+		subject, text, err := helper.RenderEmailTemplate(config.NEW_ARTICLE_TO_REVIEW, tmp.GetEmailTmps()[0].GetTitle(), tmp.GetEmailTmps()[0].GetText(), data)
+		if err != nil {
+			return nil, err
+		}
+
+		return &notification_service.CreateNotificationReq{
+			Subject: subject,
+			Text:    text,
+			Email:   reviewer.GetEmail(),
+			Status:  config.EMAIL_STATUS_NEW,
+		}, nil
+	}
+
+	return nil, errors.New("cant find suitable mail template")
+}
+
+// CleanupExpiredInvitations periodically removes reviewers who did not answer the invitation in time, it returns when ctx is done
+func CleanupExpiredInvitations(ctx context.Context, log logger.LoggerI, strg storage.StorageI, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		rowsAffected, err := strg.Submission().Invitation().DeleteExpired(ctx)
+		if err != nil {
+			log.Error("!!!CleanupExpiredInvitations--->", logger.Error(err))
+			continue
+		}
+
+		if rowsAffected > 0 {
+			log.Info("---CleanupExpiredInvitations--->", logger.Any("deleted", rowsAffected))
+		}
+	}
+}
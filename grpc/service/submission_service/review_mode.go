@@ -0,0 +1,85 @@
+package submission_service
+
+import (
+	"context"
+	"editory_submission/config"
+	"editory_submission/genproto/content_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/storage"
+)
+
+// hidesAuthor reports whether the author identity is hidden from the viewer in the journal review mode
+func hidesAuthor(reviewMode, roleType string) bool {
+	return roleType == config.REVIEWER && reviewMode == config.REVIEW_MODE_DOUBLE_BLIND
+}
+
+// hidesReviewer reports whether the reviewer identity is hidden from the viewer in the journal review mode
+func hidesReviewer(reviewMode, roleType string) bool {
+	return roleType == config.AUTHOR &&
+		(reviewMode == config.REVIEW_MODE_SINGLE_BLIND || reviewMode == config.REVIEW_MODE_DOUBLE_BLIND)
+}
+
+// draftReviewMode returns the draft with the review mode of its journal
+func draftReviewMode(ctx context.Context, strg storage.StorageI, draftId string) (draft *pb.GetArticleRes, reviewMode string, err error) {
+	draft, err = strg.Submission().Article().Get(ctx, &pb.GetArticleReq{
+		Id: draftId,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	journal, err := strg.Content().Journal().Get(ctx, &content_service.PrimaryKey{
+		Id: draft.GetJournalId(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return draft, journal.GetReviewMode(), nil
+}
+
+func maskArticleAuthor(article *pb.Article) {
+	if article == nil {
+		return
+	}
+
+	article.AuthorId = ""
+	article.AuthorIdData = nil
+	article.Coauthors = maskCoAuthors(article.GetCoauthors())
+	article.Files = maskFiles(article.GetFiles())
+}
+
+// maskCoAuthors keeps the coauthor count but drops who they are
+func maskCoAuthors(coauthors []*pb.CoAuthor) []*pb.CoAuthor {
+	for _, val := range coauthors {
+		val.UserId = ""
+		val.UserIdData = nil
+	}
+
+	return coauthors
+}
+
+// maskFiles drops cover letters, they are signed by the author
+func maskFiles(files []*pb.File) []*pb.File {
+	var res []*pb.File
+	for _, val := range files {
+		if val.GetType() == config.FILE_TYPE_COVER_LETTER {
+			continue
+		}
+
+		res = append(res, val)
+	}
+
+	return res
+}
+
+// maskDraftHistoryReviewers hides other actors from the author in blind review modes,
+// reviewers whose invitations expired are gone from draft_checker so they can't be told apart from editors
+func maskDraftHistoryReviewers(res *pb.GetDraftHistoryRes, authorId string) {
+	for _, val := range res.GetEvents() {
+		if val.GetActorId() != authorId {
+			val.ActorId = ""
+			val.ActorIdData = nil
+		}
+	}
+}
@@ -3,15 +3,31 @@ package submission_service
 import (
 	"context"
 	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	"editory_submission/genproto/content_service"
 	pb "editory_submission/genproto/submission_service"
 	"editory_submission/grpc/client"
 	"editory_submission/pkg/logger"
+	"editory_submission/pkg/security"
+	"editory_submission/pkg/submission"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"errors"
+	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// finalReviewStatus are the review results which need all required review form answers
+var finalReviewStatus = map[string]bool{
+	config.ARTICLE_REVIEWER_STATUS_APPROVED: true,
+	config.ARTICLE_REVIEWER_STATUS_REJECTED: true,
+}
+
 type checkerService struct {
 	cfg      config.Config
 	log      logger.LoggerI
@@ -32,12 +48,109 @@ func NewCheckerService(cfg config.Config, log logger.LoggerI, strg storage.Stora
 func (s *checkerService) CreateArticleChecker(ctx context.Context, req *pb.CreateArticleCheckerReq) (res *pb.CreateArticleCheckerRes, err error) {
 	s.log.Info("---CreateChecker--->", logger.Any("req", req))
 
-	res, err = s.strg.Submission().Reviewer().Create(ctx, req)
+	err = checkLatestRevision(ctx, s.strg, req.GetArticleId())
+	if err != nil {
+		s.log.Error("!!!CreateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if req.GetType() != config.REVIEWER {
+		res, err = s.strg.Submission().Reviewer().Create(ctx, req)
+		if err != nil {
+			s.log.Error("!!!CreateChecker--->", logger.Error(err))
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return res, nil
+	}
+
+	article, err := s.strg.Submission().Article().Get(ctx, &pb.GetArticleReq{
+		Id: req.GetArticleId(),
+	})
+	if err != nil {
+		s.log.Error("!!!CreateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	t, err := submission.AssignReviewer(article.GetStatus(), req.GetRoleType())
+	if err != nil {
+		s.log.Error("!!!CreateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	err = s.findReviewer(ctx, req)
+	if err != nil {
+		s.log.Error("!!!CreateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// a reviewer who isn't registered yet has no conflicts
+	if req.GetCheckerId() != "" {
+		err = s.checkConflicts(ctx, req)
+		if err != nil {
+			s.log.Error("!!!CreateChecker--->", logger.Error(err))
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	err = s.setDefaultDueAt(ctx, req, article.GetJournalId())
 	if err != nil {
 		s.log.Error("!!!CreateChecker--->", logger.Error(err))
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	expiresAt := time.Now().Add(config.ReviewerInvitationExpiresInTime)
+
+	err = s.strg.WithTx(ctx, func(strg storage.StorageI) error {
+		err = createReviewer(ctx, strg, req, article.GetJournalId())
+		if err != nil {
+			return err
+		}
+
+		res, err = strg.Submission().Reviewer().Create(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		res.Invitation, err = strg.Submission().Invitation().Create(ctx, &pb.ReviewerInvitation{
+			DraftCheckerId: res.GetId(),
+			ExpiresAt:      expiresAt.Format(config.DatabaseTimeLayout),
+		})
+		if err != nil {
+			return err
+		}
+
+		// the invitation is queued with the assignment and delivered by the outbox, so neither is saved without the other
+		invitation, err := s.makeInvitationNotification(ctx, strg, article, res.GetCheckerId(), res.GetInvitation().GetId(), expiresAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = strg.Notification().Notification().Create(ctx, invitation)
+		if err != nil {
+			return err
+		}
+
+		_, err = strg.Submission().Article().Update(ctx, &pb.UpdateArticleReq{
+			Id:             req.GetArticleId(),
+			Status:         t.To,
+			Step:           t.Step,
+			EditorStatus:   t.EditorStatus,
+			ReviewerStatus: t.ReviewerStatus,
+		})
+
+		return err
+	})
+	if err != nil {
+		s.log.Error("!!!CreateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.publishReviewAssign(ctx, article, res)
+	if err != nil {
+		s.log.Error("!!!CreateChecker---> cant publish review assignment", logger.Error(err))
+	}
+
 	return res, nil
 }
 
@@ -50,6 +163,22 @@ func (s *checkerService) GetArticleChecker(ctx context.Context, req *pb.GetArtic
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
+	roleType, err := checkerViewerRole(ctx, s.strg, res.GetCheckerId(), res.GetType(), res.GetArticleIdData(), req.GetViewerId(), map[string]bool{})
+	if err != nil {
+		s.log.Error("!!!GetChecker--->", logger.Error(err))
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if hidesAuthor(res.GetReviewMode(), roleType) {
+		maskArticleAuthor(res.ArticleIdData)
+	}
+
+	if res.GetType() == config.REVIEWER && hidesReviewer(res.GetReviewMode(), roleType) {
+		res.CheckerId = ""
+		res.CheckerIdData = nil
+		res.Conflict = nil
+	}
+
 	return res, nil
 }
 
@@ -57,17 +186,107 @@ func (s *checkerService) GetArticleCheckerList(ctx context.Context, req *pb.GetA
 	s.log.Info("---GetCheckerList--->", logger.Any("req", req))
 
 	res, err = s.strg.Submission().Reviewer().GetList(ctx, req)
-	if err != nil {
+	if errors.Is(err, util.ErrInvalidFilter) {
+		s.log.Error("!!!GetCheckerList--->", logger.Error(err))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		s.log.Error("!!!GetCheckerList--->", logger.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	editors := map[string]bool{}
+
+	for _, val := range res.GetArticleCheckers() {
+		roleType, err := checkerViewerRole(ctx, s.strg, val.GetCheckerId(), val.GetType(), val.GetArticleIdData(), req.GetViewerId(), editors)
+		if err != nil {
+			s.log.Error("!!!GetCheckerList--->", logger.Error(err))
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		if hidesAuthor(val.GetReviewMode(), roleType) {
+			maskArticleAuthor(val.ArticleIdData)
+		}
+
+		if val.GetType() == config.REVIEWER && hidesReviewer(val.GetReviewMode(), roleType) {
+			val.CheckerId = ""
+			val.CheckerIdData = nil
+			val.Conflict = nil
+		}
+	}
+
+	return res, nil
+}
+
+func (s *checkerService) GetReviewerSuggestions(ctx context.Context, req *pb.GetReviewerSuggestionsReq) (res *pb.GetReviewerSuggestionsRes, err error) {
+	s.log.Info("---GetReviewerSuggestions--->", logger.Any("req", req))
+
+	res, err = s.strg.Submission().Reviewer().GetSuggestions(ctx, req)
+	if err != nil {
+		s.log.Error("!!!GetReviewerSuggestions--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return res, nil
 }
 
 func (s *checkerService) UpdateArticleChecker(ctx context.Context, req *pb.UpdateArticleCheckerReq) (res *pb.UpdateArticleCheckerRes, err error) {
 	s.log.Info("---UpdateChecker--->", logger.Any("req", req))
 
+	checker, err := s.strg.Submission().Reviewer().Get(ctx, &pb.GetArticleCheckerReq{
+		Id: req.GetId(),
+	})
+	if err != nil {
+		s.log.Error("!!!UpdateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	err = s.checkCheckerActor(ctx, checker, req.GetActorId())
+	if err != nil {
+		s.log.Error("!!!UpdateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = checkLatestRevision(ctx, s.strg, checker.GetArticleId())
+	if err != nil {
+		s.log.Error("!!!UpdateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if checker.GetInvitation() != nil && checker.GetInvitation().GetStatus() != config.INVITATION_STATUS_ACCEPTED {
+		err = errors.New("reviewer has not accepted the invitation")
+		s.log.Error("!!!UpdateChecker--->", logger.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if len(req.GetAnswers()) > 0 || (checker.GetType() == config.REVIEWER && finalReviewStatus[req.GetStatus()]) {
+		form, err := s.strg.Content().ReviewForm().Get(ctx, &content_service.GetReviewFormReq{
+			JournalId: checker.GetArticleIdData().GetJournalId(),
+		})
+		if err != nil {
+			if !util.IsErrNoRows(err) {
+				s.log.Error("!!!UpdateChecker--->", logger.Error(err))
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			// journal without an active form has nothing to answer
+			form = nil
+		}
+
+		err = validateReviewAnswers(form, req.GetAnswers())
+		if err != nil {
+			s.log.Error("!!!UpdateChecker--->", logger.Error(err))
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if checker.GetType() == config.REVIEWER && finalReviewStatus[req.GetStatus()] {
+			err = checkRequiredAnswers(form, checker.GetAnswers(), req.GetAnswers())
+			if err != nil {
+				s.log.Error("!!!UpdateChecker--->", logger.Error(err))
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+		}
+	}
+
 	rowsAffected, err := s.strg.Submission().Reviewer().Update(ctx, req)
 	if err != nil {
 		s.log.Error("!!!UpdateChecker--->", logger.Error(err))
@@ -78,6 +297,13 @@ func (s *checkerService) UpdateArticleChecker(ctx context.Context, req *pb.Updat
 		return nil, status.Error(codes.InvalidArgument, "no rows were affected")
 	}
 
+	if req.GetStatus() != "" && req.GetStatus() != checker.GetStatus() {
+		err = s.publishCheckerStatus(ctx, checker, req.GetStatus())
+		if err != nil {
+			s.log.Error("!!!UpdateChecker---> cant publish checker status", logger.Error(err))
+		}
+	}
+
 	return &pb.UpdateArticleCheckerRes{}, nil
 }
 
@@ -98,3 +324,186 @@ func (s *checkerService) DeleteArticleChecker(ctx context.Context, req *pb.Delet
 
 	return res, nil
 }
+
+// findReviewer sets the checker to the registered user with the reviewer email
+func (s *checkerService) findReviewer(ctx context.Context, req *pb.CreateArticleCheckerReq) error {
+	if req.GetCheckerId() != "" {
+		if !util.IsValidUUID(req.GetCheckerId()) {
+			return errors.New("checker id is not valid")
+		}
+
+		return nil
+	}
+
+	if !util.IsValidEmail(req.GetReviewerEmail()) {
+		return errors.New("reviewer email is not valid")
+	}
+
+	user, err := s.strg.Auth().User().Get(ctx, &auth_service.GetUserReq{
+		Email: req.GetReviewerEmail(),
+	})
+	if err != nil {
+		if util.IsErrNoRows(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	req.CheckerId = user.GetId()
+
+	return nil
+}
+
+// createReviewer registers the reviewer who has no user yet and gives the REVIEWER role of the journal,
+// it runs in the transaction of the assignment, so a refused assignment leaves no user behind
+func createReviewer(ctx context.Context, strg storage.StorageI, req *pb.CreateArticleCheckerReq, journalId string) error {
+	if req.GetCheckerId() == "" {
+		password, err := security.HashPassword(config.DEFAULT_PASSWORD)
+		if err != nil {
+			return err
+		}
+
+		user, err := strg.Auth().User().Create(ctx, &auth_service.User{
+			FirstName: req.GetReviewerFirstName(),
+			LastName:  req.GetReviewerLastName(),
+			Email:     req.GetReviewerEmail(),
+			Password:  password,
+		})
+		if err != nil {
+			return err
+		}
+
+		req.CheckerId = user.GetId()
+	}
+
+	roles, err := strg.Auth().Role().GetList(ctx, &auth_service.GetRoleListReq{
+		Limit:     1,
+		UserId:    req.GetCheckerId(),
+		JournalId: journalId,
+		RoleTypes: []string{config.REVIEWER},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(roles.GetRoles()) > 0 {
+		return nil
+	}
+
+	_, err = strg.Auth().Role().Create(ctx, &auth_service.Role{
+		UserId:    req.GetCheckerId(),
+		JournalId: journalId,
+		RoleType:  config.REVIEWER,
+	})
+
+	return err
+}
+
+// checkConflicts refuses the reviewer who is the draft author, a coauthor or their university colleague,
+// unless the editor overrides it with a reason. Reason is recorded only for the detected conflicts
+func (s *checkerService) checkConflicts(ctx context.Context, req *pb.CreateArticleCheckerReq) error {
+	conflicts, err := s.strg.Submission().Reviewer().GetConflicts(ctx, req.GetArticleId(), req.GetCheckerId())
+	if err != nil {
+		return err
+	}
+
+	if len(conflicts) == 0 {
+		req.ConflictOverrideReason = ""
+		return nil
+	}
+
+	if strings.TrimSpace(req.GetConflictOverrideReason()) == "" {
+		return errors.New("conflict of interest: " + strings.Join(conflicts, ", ") + ", editor override reason is required")
+	}
+
+	if !util.IsValidUUID(req.GetEditorId()) {
+		return errors.New("editor id is required to override the conflict of interest")
+	}
+
+	return nil
+}
+
+// setDefaultDueAt validates the review deadline or sets it by the journal review period
+func (s *checkerService) setDefaultDueAt(ctx context.Context, req *pb.CreateArticleCheckerReq, journalId string) error {
+	if req.GetDueAt() != "" {
+		_, err := time.Parse(config.DatabaseTimeLayout, req.GetDueAt())
+		return err
+	}
+
+	journal, err := s.strg.Content().Journal().Get(ctx, &content_service.PrimaryKey{
+		Id: journalId,
+	})
+	if err != nil {
+		return err
+	}
+
+	req.DueAt = time.Now().AddDate(0, 0, int(journal.GetReviewDueDays())).Format(config.DatabaseTimeLayout)
+
+	return nil
+}
+
+// validateReviewAnswers checks that answers belong to the journal review form and match the question types
+func validateReviewAnswers(form *content_service.ReviewForm, answers []*pb.ReviewAnswer) error {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	if form == nil {
+		return errors.New("journal has no active review form")
+	}
+
+	questions := make(map[string]*content_service.ReviewFormQuestion, len(form.GetQuestions()))
+	for _, val := range form.GetQuestions() {
+		questions[val.GetId()] = val
+	}
+
+	for _, val := range answers {
+		question, ok := questions[val.GetQuestionId()]
+		if !ok {
+			return fmt.Errorf("question %s is not in the journal review form", val.GetQuestionId())
+		}
+
+		// empty value clears the answer
+		if val.GetValue() == "" {
+			continue
+		}
+
+		switch question.GetType() {
+		case config.REVIEW_QUESTION_TYPE_RATING:
+			rating, err := strconv.Atoi(val.GetValue())
+			if err != nil || int32(rating) < question.GetScaleMin() || int32(rating) > question.GetScaleMax() {
+				return fmt.Errorf("answer to %q must be a number from %d to %d", question.GetText(), question.GetScaleMin(), question.GetScaleMax())
+			}
+		case config.REVIEW_QUESTION_TYPE_YES_NO:
+			if val.GetValue() != config.REVIEW_ANSWER_YES && val.GetValue() != config.REVIEW_ANSWER_NO {
+				return fmt.Errorf("answer to %q must be %s or %s", question.GetText(), config.REVIEW_ANSWER_YES, config.REVIEW_ANSWER_NO)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkRequiredAnswers checks that saved and new answers cover all required questions of the review form
+func checkRequiredAnswers(form *content_service.ReviewForm, saved, answers []*pb.ReviewAnswer) error {
+	if form == nil {
+		return nil
+	}
+
+	values := make(map[string]string, len(saved)+len(answers))
+	for _, val := range saved {
+		values[val.GetQuestionId()] = val.GetValue()
+	}
+	for _, val := range answers {
+		values[val.GetQuestionId()] = val.GetValue()
+	}
+
+	for _, val := range form.GetQuestions() {
+		if val.GetRequired() && strings.TrimSpace(values[val.GetId()]) == "" {
+			return fmt.Errorf("answer to required question %q is missing", val.GetText())
+		}
+	}
+
+	return nil
+}
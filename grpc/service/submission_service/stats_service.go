@@ -0,0 +1,97 @@
+package submission_service
+
+import (
+	"context"
+	"editory_submission/genproto/content_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/logger"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// journalMetricsPageSize is the number of journals refreshed per page
+const journalMetricsPageSize = 100
+
+func (s *articleService) GetJournalStats(ctx context.Context, req *pb.GetJournalStatsReq) (res *pb.JournalStats, err error) {
+	s.log.Info("---GetJournalStats--->", logger.Any("req", req))
+
+	if !util.IsValidUUID(req.GetJournalId()) {
+		return nil, status.Error(codes.InvalidArgument, "journal id is an invalid uuid")
+	}
+
+	res, err = s.strg.Submission().Stats().GetJournalStats(ctx, req.GetJournalId())
+	if err != nil {
+		s.log.Error("!!!GetJournalStats--->", logger.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
+
+// RefreshJournalMetrics periodically replaces the hand-typed acceptance rate and decision time of the journals
+// with the ones computed from their submissions, it returns when ctx is done
+func RefreshJournalMetrics(ctx context.Context, log logger.LoggerI, strg storage.StorageI, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		updated, err := updateJournalMetrics(ctx, strg)
+		if err != nil {
+			log.Error("!!!RefreshJournalMetrics--->", logger.Error(err))
+		} else if updated > 0 {
+			log.Info("---RefreshJournalMetrics--->", logger.Any("updated", updated))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateJournalMetrics skips the journals without decided submissions, they keep their own values
+func updateJournalMetrics(ctx context.Context, strg storage.StorageI) (updated int, err error) {
+	for offset := int32(0); ; offset += journalMetricsPageSize {
+		journals, err := strg.Content().Journal().GetList(ctx, &content_service.GetList{
+			Offset: offset,
+			Limit:  journalMetricsPageSize,
+			Sort:   "created_at,id",
+		})
+		if err != nil {
+			return updated, err
+		}
+
+		for _, journal := range journals.GetJournals() {
+			stats, err := strg.Submission().Stats().GetJournalStats(ctx, journal.GetId())
+			if err != nil {
+				return updated, err
+			}
+
+			if stats.GetAccepted()+stats.GetRejected() == 0 {
+				continue
+			}
+
+			rowsAffected, err := strg.Content().Journal().UpdateMetrics(
+				ctx,
+				journal.GetId(),
+				fmt.Sprintf("%.0f%%", stats.GetAcceptanceRate()),
+				fmt.Sprintf("%.0f days", stats.GetMedianDaysToFinalDecision()),
+			)
+			if err != nil {
+				return updated, err
+			}
+
+			updated += int(rowsAffected)
+		}
+
+		if len(journals.GetJournals()) < journalMetricsPageSize {
+			return updated, nil
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package submission_service
+
+import (
+	"context"
+	"editory_submission/config"
+	"editory_submission/genproto/auth_service"
+	"editory_submission/genproto/notification_service"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/grpc/client"
+)
+
+// publishUserNotification adds the notification to the in-app inbox of the user
+func publishUserNotification(ctx context.Context, services client.ServiceManagerI, userId, notificationType string, payload map[string]string) error {
+	_, err := services.UserNotificationService().CreateUserNotification(ctx, &notification_service.CreateUserNotificationReq{
+		UserId:  userId,
+		Type:    notificationType,
+		Payload: payload,
+	})
+
+	return err
+}
+
+// publishDraftStatus tells the draft author about the status set by the editor or the reviewer
+func (s *articleService) publishDraftStatus(ctx context.Context, draft *pb.GetArticleRes, newStatus string) error {
+	return publishUserNotification(ctx, s.services, draft.GetAuthorId(), config.USER_NOTIFICATION_DRAFT_STATUS, map[string]string{
+		"draft_id":    draft.GetId(),
+		"draft_title": draft.GetTitle(),
+		"journal_id":  draft.GetJournalId(),
+		"old_status":  draft.GetStatus(),
+		"new_status":  newStatus,
+	})
+}
+
+// publishReviewAssign tells the reviewer about the draft waiting for the review
+func (s *checkerService) publishReviewAssign(ctx context.Context, draft *pb.GetArticleRes, checker *pb.CreateArticleCheckerRes) error {
+	return publishUserNotification(ctx, s.services, checker.GetCheckerId(), config.USER_NOTIFICATION_REVIEW_ASSIGN, map[string]string{
+		"review_id":   checker.GetId(),
+		"draft_id":    draft.GetId(),
+		"draft_title": draft.GetTitle(),
+		"journal_id":  draft.GetJournalId(),
+		"due_at":      checker.GetDueAt(),
+	})
+}
+
+// publishCheckerStatus tells the journal editors about the review result and the draft author about other checks.
+// The checker isn't part of the payload, so blind reviews stay blind
+func (s *checkerService) publishCheckerStatus(ctx context.Context, checker *pb.GetArticleCheckerRes, newStatus string) error {
+	draft := checker.GetArticleIdData()
+
+	payload := map[string]string{
+		"check_id":    checker.GetId(),
+		"check_type":  checker.GetType(),
+		"draft_id":    checker.GetArticleId(),
+		"draft_title": draft.GetTitle(),
+		"journal_id":  draft.GetJournalId(),
+		"old_status":  checker.GetStatus(),
+		"new_status":  newStatus,
+	}
+
+	if checker.GetType() != config.REVIEWER {
+		return publishUserNotification(ctx, s.services, draft.GetAuthorId(), config.USER_NOTIFICATION_CHECKER_STATUS, payload)
+	}
+
+	editors, err := s.strg.Auth().Role().GetList(ctx, &auth_service.GetRoleListReq{
+		JournalId: draft.GetJournalId(),
+		RoleTypes: []string{config.EDITOR},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, editor := range editors.GetRoles() {
+		err = publishUserNotification(ctx, s.services, editor.GetUserId(), config.USER_NOTIFICATION_REVIEW_STATUS, payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+create table "permission" (
+    "id" uuid primary key,
+    "role_type" role_type not null,
+    "path" varchar not null,
+    "method" varchar not null default '*',
+    "created_at" timestamp default CURRENT_TIMESTAMP,
+    "updated_at" timestamp default CURRENT_TIMESTAMP
+);
+
+alter table "permission" add constraint permission_role_path_method unique (role_type, path, method);
+
+insert into "permission" (id, role_type, path, method) values
+    (uuid_generate_v4(), 'SUPERADMIN', '/admin/*', '*'),
+    (uuid_generate_v4(), 'SUPERADMIN', '/user/:user-id/*', '*'),
+    (uuid_generate_v4(), 'EDITOR', '/journal/*', '*'),
+    (uuid_generate_v4(), 'EDITOR', '/user/:user-id/*', '*'),
+    (uuid_generate_v4(), 'PROOFREADER', '/user/:user-id/*', '*'),
+    (uuid_generate_v4(), 'REVIEWER', '/user/:user-id/*', '*'),
+    (uuid_generate_v4(), 'AUTHOR', '/user/:user-id/*', '*');
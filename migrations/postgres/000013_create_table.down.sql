@@ -0,0 +1,2 @@
+drop table if exists "draft_event";
+drop type if exists "draft_event_type";
@@ -0,0 +1,23 @@
+create type "draft_event_type" as enum (
+    'DRAFT_UPDATE',
+    'CHECKER_CREATE',
+    'CHECKER_UPDATE'
+);
+
+create table "draft_event" (
+    "id" uuid primary key,
+    "draft_id" uuid not null,
+    "actor_id" uuid,
+    "type" draft_event_type not null,
+    "old_status" varchar,
+    "new_status" varchar,
+    "old_step" varchar,
+    "new_step" varchar,
+    "comment" text,
+    "created_at" timestamp default CURRENT_TIMESTAMP
+);
+
+alter table "draft_event" add foreign key ("draft_id") references "draft"("id") on delete cascade;
+alter table "draft_event" add foreign key ("actor_id") references "user"("id") on delete set null;
+
+create index draft_event_draft_id_idx on "draft_event" ("draft_id", "created_at");
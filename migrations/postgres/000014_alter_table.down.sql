@@ -0,0 +1,3 @@
+drop index if exists draft_group_id_revision_idx;
+
+alter table "draft" drop column "revision";
@@ -0,0 +1,14 @@
+alter table "draft" add column "revision" int not null default 1;
+
+update "draft" d set "group_id" = d."id" where d."group_id" is null;
+
+update "draft" d set "revision" = r."revision"
+from (
+    select "id", row_number() over (partition by "group_id" order by "created_at") as "revision"
+    from "draft"
+) r
+where d."id" = r."id";
+
+create index draft_group_id_revision_idx on "draft" ("group_id", "revision");
+
+alter type "draft_event_type" add value 'REVISION_CREATE';
@@ -0,0 +1,4 @@
+delete from "email_template" where "type" = 'NEW_ARTICLE_TO_REVIEW';
+
+drop table if exists "reviewer_invitation";
+drop type if exists "invitation_status";
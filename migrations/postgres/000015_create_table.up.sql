@@ -0,0 +1,43 @@
+alter type "checker_status" add value 'DECLINED';
+
+create type "invitation_status" as enum (
+    'SENT',
+    'ACCEPTED',
+    'DECLINED'
+);
+
+create table "reviewer_invitation" (
+    "id" uuid primary key,
+    "draft_checker_id" uuid not null unique,
+    "status" invitation_status not null default 'SENT',
+    "expires_at" timestamp not null,
+    "responded_at" timestamp,
+    "created_at" timestamp default CURRENT_TIMESTAMP,
+    "updated_at" timestamp default CURRENT_TIMESTAMP
+);
+
+alter table "reviewer_invitation" add foreign key ("draft_checker_id") references "draft_checker"("id") on delete cascade;
+
+create index reviewer_invitation_status_expires_at_idx on "reviewer_invitation" ("status", "expires_at");
+
+insert into "email_template" ("title", "description", "type", "text") values (
+    'Invitation to review "{{draft_title}}"',
+    'Sent to a reviewer when an editor assigns a draft. Variables: first_name, last_name, draft_title, accept_link, decline_link, expires_at',
+    'NEW_ARTICLE_TO_REVIEW',
+    '<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>Invitation to review</title>
+</head>
+<body style="font-family: Arial, sans-serif;">
+    <div>
+        <h1>Hello, {{first_name}}!</h1>
+        <p>You are invited to review the manuscript "{{draft_title}}".</p>
+        <p><a href="{{accept_link}}" target="_blank">Accept</a> or <a href="{{decline_link}}" target="_blank">Decline</a></p>
+        <p>The invitation expires at {{expires_at}}.</p>
+        <p>Best regards,<br>Editorypress Submission System</p>
+    </div>
+</body>
+</html>'
+) on conflict ("type") do nothing;
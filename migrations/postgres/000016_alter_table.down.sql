@@ -0,0 +1,7 @@
+drop index if exists draft_checker_due_at_idx;
+
+alter table "draft_checker" drop column "overdue_at";
+alter table "draft_checker" drop column "reminded_at";
+alter table "draft_checker" drop column "due_at";
+
+alter table "journal" drop column "review_due_days";
@@ -0,0 +1,11 @@
+alter table "journal" add column "review_due_days" int not null default 21;
+
+alter table "draft_checker" add column "due_at" timestamp;
+alter table "draft_checker" add column "reminded_at" timestamp;
+alter table "draft_checker" add column "overdue_at" timestamp;
+
+create index draft_checker_due_at_idx on "draft_checker" ("due_at") where "due_at" is not null;
+
+alter type "email_template_type" add value 'REVIEW_REMINDER';
+alter type "email_template_type" add value 'REVIEW_OVERDUE';
+alter type "email_template_type" add value 'REVIEW_OVERDUE_EDITOR';
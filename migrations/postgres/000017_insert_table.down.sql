@@ -0,0 +1 @@
+delete from "email_template" where "type" in ('REVIEW_REMINDER', 'REVIEW_OVERDUE', 'REVIEW_OVERDUE_EDITOR');
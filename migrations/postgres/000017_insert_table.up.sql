@@ -0,0 +1,22 @@
+insert into "email_template" ("title", "description", "type", "text") values (
+    'Review of "{{draft_title}}" is due {{due_at}}',
+    'Sent to a reviewer before the review deadline. Variables: first_name, last_name, draft_title, due_at',
+    'REVIEW_REMINDER',
+    '<p>Hello, {{first_name}}!</p>
+<p>This is a reminder that your review of "{{draft_title}}" is due {{due_at}}.</p>
+<p>Best regards,<br>Editorypress Submission System</p>'
+), (
+    'Review of "{{draft_title}}" is overdue',
+    'Sent to a reviewer when the review deadline passes. Variables: first_name, last_name, draft_title, due_at',
+    'REVIEW_OVERDUE',
+    '<p>Hello, {{first_name}}!</p>
+<p>Your review of "{{draft_title}}" was due {{due_at}}. Please submit it as soon as possible.</p>
+<p>Best regards,<br>Editorypress Submission System</p>'
+), (
+    'Review of "{{draft_title}}" is overdue',
+    'Sent to the journal editors when a review deadline passes. Variables: first_name, last_name, draft_title, due_at, reviewer_name, reviewer_email',
+    'REVIEW_OVERDUE_EDITOR',
+    '<p>Hello, {{first_name}}!</p>
+<p>The review of "{{draft_title}}" by {{reviewer_name}} ({{reviewer_email}}) was due {{due_at}} and is not submitted yet.</p>
+<p>Best regards,<br>Editorypress Submission System</p>'
+) on conflict ("type") do nothing;
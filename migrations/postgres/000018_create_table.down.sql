@@ -0,0 +1,4 @@
+drop table if exists "draft_checker_answer";
+drop table if exists "review_form_question";
+drop table if exists "review_form";
+drop type if exists "review_question_type";
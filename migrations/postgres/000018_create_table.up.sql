@@ -0,0 +1,49 @@
+create type "review_question_type" as enum (
+    'RATING',
+    'YES_NO',
+    'TEXT'
+);
+
+create table "review_form" (
+    "id" uuid primary key,
+    "journal_id" uuid not null,
+    "title" varchar not null,
+    "description" text,
+    "active" boolean not null default false,
+    "created_at" timestamp default CURRENT_TIMESTAMP,
+    "updated_at" timestamp default CURRENT_TIMESTAMP
+);
+
+alter table "review_form" add foreign key ("journal_id") references "journal"("id") on delete cascade;
+
+create unique index review_form_journal_id_active_idx on "review_form" ("journal_id") where "active";
+
+create table "review_form_question" (
+    "id" uuid primary key,
+    "review_form_id" uuid not null,
+    "type" review_question_type not null default 'TEXT',
+    "text" text not null,
+    "required" boolean not null default false,
+    "scale_min" int not null default 0,
+    "scale_max" int not null default 0,
+    "position" int not null default 0,
+    "created_at" timestamp default CURRENT_TIMESTAMP,
+    "updated_at" timestamp default CURRENT_TIMESTAMP
+);
+
+alter table "review_form_question" add foreign key ("review_form_id") references "review_form"("id") on delete cascade;
+
+create index review_form_question_review_form_id_idx on "review_form_question" ("review_form_id", "position");
+
+create table "draft_checker_answer" (
+    "id" uuid primary key,
+    "draft_checker_id" uuid not null,
+    "question_id" uuid not null,
+    "value" text not null default '',
+    "created_at" timestamp default CURRENT_TIMESTAMP,
+    "updated_at" timestamp default CURRENT_TIMESTAMP,
+    unique ("draft_checker_id", "question_id")
+);
+
+alter table "draft_checker_answer" add foreign key ("draft_checker_id") references "draft_checker"("id") on delete cascade;
+alter table "draft_checker_answer" add foreign key ("question_id") references "review_form_question"("id");
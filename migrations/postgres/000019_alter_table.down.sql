@@ -0,0 +1,3 @@
+alter table "journal" drop column if exists "review_mode";
+
+drop type if exists "review_mode";
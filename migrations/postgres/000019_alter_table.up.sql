@@ -0,0 +1,7 @@
+create type "review_mode" as enum (
+    'OPEN',
+    'SINGLE_BLIND',
+    'DOUBLE_BLIND'
+);
+
+alter table "journal" add column "review_mode" review_mode not null default 'OPEN';
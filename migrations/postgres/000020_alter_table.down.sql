@@ -0,0 +1 @@
+-- enum values can't be dropped, the templates using them are removed by 000021
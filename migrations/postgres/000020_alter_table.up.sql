@@ -0,0 +1,3 @@
+alter type "email_template_type" add value 'DECISION_ACCEPT';
+alter type "email_template_type" add value 'DECISION_REJECT';
+alter type "email_template_type" add value 'DECISION_REVISE';
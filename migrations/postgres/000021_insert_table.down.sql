@@ -0,0 +1 @@
+delete from "email_template" where "type" in ('DECISION_ACCEPT', 'DECISION_REJECT', 'DECISION_REVISE');
@@ -0,0 +1,29 @@
+insert into "email_template" ("title", "description", "type", "text") values (
+    'Decision on "{{draft_title}}": accepted',
+    'Sent to the author when the editor confirms a draft. Variables: first_name, last_name, draft_title, editor_comment, reviewer_comments',
+    'DECISION_ACCEPT',
+    '<p>Hello, {{first_name}}!</p>
+<p>We are pleased to inform you that your manuscript "{{draft_title}}" has been accepted.</p>
+<p>{{editor_comment}}</p>
+{{reviewer_comments}}
+<p>Best regards,<br>Editorypress Submission System</p>'
+), (
+    'Decision on "{{draft_title}}": rejected',
+    'Sent to the author when the editor denies a draft. Variables: first_name, last_name, draft_title, editor_comment, reviewer_comments',
+    'DECISION_REJECT',
+    '<p>Hello, {{first_name}}!</p>
+<p>We regret to inform you that your manuscript "{{draft_title}}" has not been accepted.</p>
+<p>{{editor_comment}}</p>
+{{reviewer_comments}}
+<p>Best regards,<br>Editorypress Submission System</p>'
+), (
+    'Decision on "{{draft_title}}": revision required',
+    'Sent to the author when the editor sends a draft back for correction. Variables: first_name, last_name, draft_title, editor_comment, reviewer_comments',
+    'DECISION_REVISE',
+    '<p>Hello, {{first_name}}!</p>
+<p>Your manuscript "{{draft_title}}" needs a revision before it can be accepted.</p>
+<p>{{editor_comment}}</p>
+{{reviewer_comments}}
+<p>Please submit the revised version in the submission system.</p>
+<p>Best regards,<br>Editorypress Submission System</p>'
+) on conflict ("type") do nothing;
@@ -0,0 +1,2 @@
+drop table if exists "article_keyword";
+drop table if exists "draft_keyword";
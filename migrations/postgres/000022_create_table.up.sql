@@ -0,0 +1,21 @@
+create table "draft_keyword" (
+    "draft_id" uuid not null,
+    "keyword_id" uuid not null,
+    primary key ("draft_id", "keyword_id")
+);
+
+alter table "draft_keyword" add foreign key ("draft_id") references "draft"("id") on delete cascade;
+alter table "draft_keyword" add foreign key ("keyword_id") references "keyword"("id") on delete cascade;
+
+create index draft_keyword_keyword_id_idx on "draft_keyword" ("keyword_id");
+
+create table "article_keyword" (
+    "article_id" uuid not null,
+    "keyword_id" uuid not null,
+    primary key ("article_id", "keyword_id")
+);
+
+alter table "article_keyword" add foreign key ("article_id") references "article"("id") on delete cascade;
+alter table "article_keyword" add foreign key ("keyword_id") references "keyword"("id") on delete cascade;
+
+create index article_keyword_keyword_id_idx on "article_keyword" ("keyword_id");
@@ -0,0 +1,5 @@
+alter table "draft_checker" drop column "conflict_override_by";
+alter table "draft_checker" drop column "conflict_override_reason";
+alter table "draft_checker" drop column "conflict_statement";
+alter table "draft_checker" drop column "has_conflict";
+alter table "draft_checker" drop column "conflict_declared_at";
@@ -0,0 +1,7 @@
+alter table "draft_checker" add column "conflict_declared_at" timestamp;
+alter table "draft_checker" add column "has_conflict" boolean not null default false;
+alter table "draft_checker" add column "conflict_statement" text;
+alter table "draft_checker" add column "conflict_override_reason" text;
+alter table "draft_checker" add column "conflict_override_by" uuid;
+
+alter table "draft_checker" add foreign key ("conflict_override_by") references "user"("id") on delete set null;
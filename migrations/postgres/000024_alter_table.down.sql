@@ -0,0 +1,10 @@
+drop index if exists draft_search_vector_idx;
+drop index if exists journal_search_vector_idx;
+drop index if exists article_search_vector_idx;
+
+alter table "draft" drop column "search_vector";
+alter table "journal" drop column "search_vector";
+alter table "article" drop column "search_vector";
+
+drop function if exists "multilingual_tsquery"(text);
+drop function if exists "multilingual_tsvector"(text);
@@ -0,0 +1,33 @@
+-- postgres has no uzbek dictionary, uzbek words are matched as they are by the simple config
+create or replace function "multilingual_tsvector"(text) returns tsvector as $$
+    select to_tsvector('english', coalesce($1, ''))
+        || to_tsvector('russian', coalesce($1, ''))
+        || to_tsvector('simple', coalesce($1, ''))
+$$ language sql immutable;
+
+create or replace function "multilingual_tsquery"(text) returns tsquery as $$
+    select websearch_to_tsquery('english', coalesce($1, ''))
+        || websearch_to_tsquery('russian', coalesce($1, ''))
+        || websearch_to_tsquery('simple', coalesce($1, ''))
+$$ language sql immutable;
+
+alter table "article" add column "search_vector" tsvector generated always as (
+    setweight(multilingual_tsvector("title"), 'A')
+        || setweight(multilingual_tsvector("description"), 'B')
+        || setweight(multilingual_tsvector("content"), 'C')
+) stored;
+
+alter table "journal" add column "search_vector" tsvector generated always as (
+    setweight(multilingual_tsvector("title"), 'A')
+        || setweight(multilingual_tsvector("short_description"), 'B')
+        || setweight(multilingual_tsvector("description"), 'B')
+) stored;
+
+alter table "draft" add column "search_vector" tsvector generated always as (
+    setweight(multilingual_tsvector("title"), 'A')
+        || setweight(multilingual_tsvector("description"), 'B')
+) stored;
+
+create index article_search_vector_idx on "article" using gin ("search_vector");
+create index journal_search_vector_idx on "journal" using gin ("search_vector");
+create index draft_search_vector_idx on "draft" using gin ("search_vector");
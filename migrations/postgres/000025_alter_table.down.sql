@@ -0,0 +1,8 @@
+-- enum values can't be dropped, DEAD notifications are left as FAILED
+update "notification" set "status" = 'FAILED' where "status" = 'DEAD';
+
+drop index if exists notification_outbox_idx;
+
+alter table "notification" drop column "last_error";
+alter table "notification" drop column "next_attempt_at";
+alter table "notification" drop column "attempts";
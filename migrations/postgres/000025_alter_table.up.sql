@@ -0,0 +1,7 @@
+alter type "notification_status" add value 'DEAD';
+
+alter table "notification" add column "attempts" int not null default 0;
+alter table "notification" add column "next_attempt_at" timestamp not null default current_timestamp;
+alter table "notification" add column "last_error" text;
+
+create index notification_outbox_idx on "notification" ("next_attempt_at") where "status" <> 'SENT';
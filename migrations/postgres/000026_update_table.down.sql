@@ -0,0 +1,3 @@
+update "email_template" set
+    "title" = regexp_replace("title", '\{\{\.([a-z_]+)\}\}', '{{\1}}', 'g'),
+    "text" = regexp_replace("text", '\{\{\.([a-z_]+)\}\}', '{{\1}}', 'g');
@@ -0,0 +1,13 @@
+-- the templates are rendered by html/template, the old placeholders {{x}}, @[#x](#x), ##x and #x become {{.x}}
+update "email_template" set
+    "title" = regexp_replace("title", '@\[#([a-z_]+)\]\(#\1\)', '{{.\1}}', 'g'),
+    "text" = regexp_replace("text", '@\[#([a-z_]+)\]\(#\1\)', '{{.\1}}', 'g');
+
+update "email_template" set
+    "title" = regexp_replace("title", '\{\{\s*([a-z_]+)\s*\}\}', '{{.\1}}', 'g'),
+    "text" = regexp_replace("text", '\{\{\s*([a-z_]+)\s*\}\}', '{{.\1}}', 'g');
+
+-- hashtags are converted only for the known variables, so that the colors and the anchors are kept
+update "email_template" set
+    "title" = regexp_replace("title", '##?(first_name|last_name|email|phone|link|draft_title|accept_link|decline_link|expires_at|due_at|reviewer_name|reviewer_email|editor_comment|reviewer_comments)\y', '{{.\1}}', 'g'),
+    "text" = regexp_replace("text", '##?(first_name|last_name|email|phone|link|draft_title|accept_link|decline_link|expires_at|due_at|reviewer_name|reviewer_email|editor_comment|reviewer_comments)\y', '{{.\1}}', 'g');
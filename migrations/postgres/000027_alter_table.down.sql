@@ -0,0 +1,9 @@
+delete from "email_template" where "language" <> 'en';
+
+alter table "email_template" drop constraint if exists email_template_type_language_key;
+alter table "email_template" add constraint "email_template_type_key" unique ("type");
+alter table "email_template" drop column "language";
+
+alter table "user" drop column "preferred_language";
+
+drop type if exists "language";
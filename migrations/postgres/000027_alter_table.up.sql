@@ -0,0 +1,11 @@
+create type "language" as enum (
+    'en',
+    'ru',
+    'uz'
+);
+
+alter table "user" add column "preferred_language" language;
+
+alter table "email_template" add column "language" language not null default 'en';
+alter table "email_template" drop constraint if exists "email_template_type_key";
+alter table "email_template" add constraint email_template_type_language_key unique ("type", "language");
@@ -0,0 +1,13 @@
+create table "user_notification" (
+    "id" uuid primary key,
+    "user_id" uuid not null,
+    "type" varchar not null,
+    "payload" jsonb not null default '{}',
+    "read_at" timestamp,
+    "created_at" timestamp default CURRENT_TIMESTAMP
+);
+
+alter table "user_notification" add foreign key ("user_id") references "user"("id") on delete cascade;
+
+create index user_notification_user_id_idx on "user_notification" ("user_id", "created_at");
+create index user_notification_unread_idx on "user_notification" ("user_id") where "read_at" is null;
@@ -0,0 +1 @@
+alter table "draft_checker" drop column "completed_at";
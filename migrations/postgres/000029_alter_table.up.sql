@@ -0,0 +1,3 @@
+alter table "draft_checker" add column "completed_at" timestamp;
+
+update "draft_checker" set "completed_at" = "updated_at" where "status" in ('APPROVED', 'REJECTED', 'BACK_FOR_CORRECTION');
@@ -0,0 +1 @@
+delete from "user" where "id" = '00000000-0000-0000-0000-000000000001';
@@ -0,0 +1,4 @@
+-- the system user is the actor of the changes made by the scheduled jobs, it can't log in
+insert into "user" ("id", "first_name", "email", "email_verification")
+values ('00000000-0000-0000-0000-000000000001', 'System', 'system@editory.local', false)
+on conflict ("id") do nothing;
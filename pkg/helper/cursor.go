@@ -0,0 +1,41 @@
+package helper
+
+import (
+	"editory_submission/config"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor builds the opaque keyset pagination cursor from the created_at and id of the last row
+func EncodeCursor(createdAt, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt + "," + id))
+}
+
+// DecodeCursor returns the created_at and id the cursor was built from
+func DecodeCursor(cursor string) (createdAt, id string, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", "", ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(b), ",")
+	if !ok {
+		return "", "", ErrInvalidCursor
+	}
+
+	if _, err = time.Parse(config.CursorTimeLayout, createdAt); err != nil {
+		return "", "", ErrInvalidCursor
+	}
+
+	if _, err = uuid.Parse(id); err != nil {
+		return "", "", ErrInvalidCursor
+	}
+
+	return createdAt, id, nil
+}
@@ -0,0 +1,57 @@
+package helper
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		createdAt string
+		id        string
+	}{
+		{name: "microseconds", createdAt: "2024-03-01T10:20:30.123456", id: "4f1a3c2e-8b7d-4e6f-9a0b-1c2d3e4f5a6b"},
+		{name: "zero microseconds", createdAt: "2024-12-31T23:59:59.000000", id: "00000000-0000-0000-0000-000000000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createdAt, id, err := DecodeCursor(EncodeCursor(tt.createdAt, tt.id))
+			if err != nil {
+				t.Fatalf("DecodeCursor() unexpected error: %v", err)
+			}
+
+			if createdAt != tt.createdAt || id != tt.id {
+				t.Errorf("DecodeCursor() = %q, %q, want %q, %q", createdAt, id, tt.createdAt, tt.id)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "no separator", cursor: encode("2024-03-01T10:20:30.123456")},
+		{name: "invalid time", cursor: encode("yesterday,4f1a3c2e-8b7d-4e6f-9a0b-1c2d3e4f5a6b")},
+		{name: "time without microseconds", cursor: encode("2024-03-01T10:20:30,4f1a3c2e-8b7d-4e6f-9a0b-1c2d3e4f5a6b")},
+		{name: "invalid id", cursor: encode("2024-03-01T10:20:30.123456,42")},
+		{name: "sql in id", cursor: encode("2024-03-01T10:20:30.123456,' OR 1=1 --")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := DecodeCursor(tt.cursor); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeCursor() error = %v, want %v", err, ErrInvalidCursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package helper
+
+import (
+	"bytes"
+	"editory_submission/config"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	text_template "text/template"
+	"text/template/parse"
+)
+
+// EmailTemplateVariables are the variables of the templates of every email_template_type.
+// Templates are rendered by html/template, a variable is written like {{.first_name}}
+var EmailTemplateVariables = map[string][]string{
+	config.REGISTRATION:          withUserVariables(),
+	config.RESET_PASSWORD:        withUserVariables(),
+	config.ACCOUNT_DEACTIVATION:  withUserVariables(),
+	config.NEW_JOURNAL_USER:      withUserVariables(),
+	config.NEW_ARTICLE_TO_REVIEW: withUserVariables("draft_title", "accept_link", "decline_link", "expires_at"),
+	config.REVIEW_REMINDER:       withUserVariables("draft_title", "due_at", "reviewer_name", "reviewer_email"),
+	config.REVIEW_OVERDUE:        withUserVariables("draft_title", "due_at", "reviewer_name", "reviewer_email"),
+	config.REVIEW_OVERDUE_EDITOR: withUserVariables("draft_title", "due_at", "reviewer_name", "reviewer_email"),
+	config.DECISION_ACCEPT:       withUserVariables("draft_title", "editor_comment", "reviewer_comments"),
+	config.DECISION_REJECT:       withUserVariables("draft_title", "editor_comment", "reviewer_comments"),
+	config.DECISION_REVISE:       withUserVariables("draft_title", "editor_comment", "reviewer_comments"),
+}
+
+// htmlVariables are built as HTML by the services, so they aren't escaped
+var htmlVariables = map[string]bool{
+	"reviewer_comments": true,
+}
+
+// emailTemplateSamples are the values of the template previews
+var emailTemplateSamples = map[string]string{
+	"first_name":        "John",
+	"last_name":         "Doe",
+	"email":             "john.doe@example.com",
+	"phone":             "+998901234567",
+	"link":              "https://example.com/verify?token=sample",
+	"draft_title":       "Sample manuscript",
+	"accept_link":       "https://example.com/reviewer/invitation?action=accept",
+	"decline_link":      "https://example.com/reviewer/invitation?action=decline",
+	"expires_at":        "2024-01-15T12:00:00Z",
+	"due_at":            "2024-01-15T12:00:00Z",
+	"reviewer_name":     "Jane Roe",
+	"reviewer_email":    "jane.roe@example.com",
+	"editor_comment":    "Thank you for your submission.",
+	"reviewer_comments": "<p><b>Reviewer 1</b></p><p>The manuscript is clearly written.</p>",
+}
+
+// withUserVariables adds the variables of the recipient which are filled for every mail
+func withUserVariables(names ...string) []string {
+	return append([]string{"first_name", "last_name", "email", "phone", "link"}, names...)
+}
+
+// ValidateEmailTemplate parses the subject and the text of the template and rejects the variables unknown to the type
+func ValidateEmailTemplate(mailType, subject, text string) error {
+	variables, ok := EmailTemplateVariables[mailType]
+	if !ok {
+		return fmt.Errorf("unknown email template type %q", mailType)
+	}
+
+	subjectTmp, textTmp, err := parseEmailTemplate(subject, text)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(variables))
+	for _, name := range variables {
+		known[name] = true
+	}
+
+	var trees []*parse.Tree
+	for _, val := range subjectTmp.Templates() {
+		trees = append(trees, val.Tree)
+	}
+	for _, val := range textTmp.Templates() {
+		trees = append(trees, val.Tree)
+	}
+
+	unknown := make(map[string]bool)
+	for _, tree := range trees {
+		if tree == nil {
+			continue
+		}
+
+		for _, name := range templateFields(tree.Root) {
+			if !known[name] {
+				unknown[name] = true
+			}
+		}
+	}
+
+	if len(unknown) > 0 {
+		names := make([]string, 0, len(unknown))
+		for name := range unknown {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return fmt.Errorf("unknown variables %s, %s templates can use %s", strings.Join(names, ", "), mailType, strings.Join(variables, ", "))
+	}
+
+	return nil
+}
+
+// RenderEmailTemplate fills the subject and the text with the data, the values are HTML escaped in the text.
+// Variables of the type missing from the data are left empty, other unknown variables fail the rendering
+func RenderEmailTemplate(mailType, subject, text string, data map[string]string) (string, string, error) {
+	subjectTmp, textTmp, err := parseEmailTemplate(subject, text)
+	if err != nil {
+		return "", "", err
+	}
+
+	values := make(map[string]interface{})
+	for _, name := range EmailTemplateVariables[mailType] {
+		values[name] = ""
+	}
+
+	for key, val := range data {
+		if htmlVariables[key] {
+			values[key] = template.HTML(val)
+		} else {
+			values[key] = val
+		}
+	}
+
+	var subjectBuf, textBuf bytes.Buffer
+
+	err = subjectTmp.Execute(&subjectBuf, values)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid subject: %w", err)
+	}
+
+	err = textTmp.Execute(&textBuf, values)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid text: %w", err)
+	}
+
+	return subjectBuf.String(), textBuf.String(), nil
+}
+
+// PreviewEmailTemplate renders the template of the type with the sample data
+func PreviewEmailTemplate(mailType, subject, text string) (string, string, error) {
+	err := ValidateEmailTemplate(mailType, subject, text)
+	if err != nil {
+		return "", "", err
+	}
+
+	data := make(map[string]string)
+	for _, name := range EmailTemplateVariables[mailType] {
+		data[name] = emailTemplateSamples[name]
+	}
+
+	return RenderEmailTemplate(mailType, subject, text, data)
+}
+
+// parseEmailTemplate parses the subject as plain text and the text as HTML
+func parseEmailTemplate(subject, text string) (*text_template.Template, *template.Template, error) {
+	subjectTmp, err := text_template.New("subject").Option("missingkey=error").Parse(subject)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid subject: %w", err)
+	}
+
+	textTmp, err := template.New("text").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid text: %w", err)
+	}
+
+	return subjectTmp, textTmp, nil
+}
+
+// templateFields returns the names of the data fields used by the node like .first_name or $.first_name
+func templateFields(node parse.Node) (res []string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, val := range n.Nodes {
+			res = append(res, templateFields(val)...)
+		}
+	case *parse.ActionNode:
+		res = templateFields(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, val := range n.Cmds {
+			res = append(res, templateFields(val)...)
+		}
+	case *parse.CommandNode:
+		for _, val := range n.Args {
+			res = append(res, templateFields(val)...)
+		}
+	case *parse.IfNode:
+		res = branchFields(&n.BranchNode)
+	case *parse.RangeNode:
+		res = branchFields(&n.BranchNode)
+	case *parse.WithNode:
+		res = branchFields(&n.BranchNode)
+	case *parse.TemplateNode:
+		res = templateFields(n.Pipe)
+	case *parse.ChainNode:
+		res = templateFields(n.Node)
+	case *parse.FieldNode:
+		res = n.Ident[:1]
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			res = n.Ident[1:2]
+		}
+	}
+
+	return res
+}
+
+func branchFields(n *parse.BranchNode) (res []string) {
+	res = append(res, templateFields(n.Pipe)...)
+	res = append(res, templateFields(n.List)...)
+	res = append(res, templateFields(n.ElseList)...)
+
+	return res
+}
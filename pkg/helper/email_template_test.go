@@ -0,0 +1,128 @@
+package helper
+
+import (
+	"editory_submission/config"
+	"strings"
+	"testing"
+)
+
+func TestValidateEmailTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		mailType string
+		subject  string
+		text     string
+		err      string
+	}{
+		{name: "known variables", mailType: config.REGISTRATION, subject: "Welcome {{.first_name}}", text: "<a href=\"{{.link}}\">Verify {{.email}}</a>"},
+		{name: "variables of the type", mailType: config.DECISION_ACCEPT, subject: "{{.draft_title}}", text: "{{.editor_comment}}{{.reviewer_comments}}"},
+		{name: "variables in branches", mailType: config.REVIEW_REMINDER, subject: "Reminder", text: "{{if .due_at}}Due {{.due_at}}{{else}}{{$.draft_title}}{{end}}"},
+		{name: "unknown type", mailType: "UNKNOWN", subject: "Hi", text: "Hi", err: "unknown email template type"},
+		{name: "unknown variable in text", mailType: config.REGISTRATION, subject: "Hi", text: "{{.draft_title}}", err: "unknown variables draft_title"},
+		{name: "unknown variable in subject", mailType: config.REGISTRATION, subject: "{{.password}}", text: "Hi", err: "unknown variables password"},
+		{name: "unknown variable in branch", mailType: config.REGISTRATION, subject: "Hi", text: "{{with .token}}{{.}}{{end}}", err: "unknown variables token"},
+		{name: "invalid subject", mailType: config.REGISTRATION, subject: "{{.first_name", text: "Hi", err: "invalid subject"},
+		{name: "invalid text", mailType: config.REGISTRATION, subject: "Hi", text: "{{if .first_name}}", err: "invalid text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEmailTemplate(tt.mailType, tt.subject, tt.text)
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("ValidateEmailTemplate() unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("ValidateEmailTemplate() error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestRenderEmailTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		mailType string
+		subject  string
+		text     string
+		data     map[string]string
+		wantSubj string
+		wantText string
+		err      bool
+	}{
+		{
+			name:     "fills variables",
+			mailType: config.REGISTRATION,
+			subject:  "Welcome {{.first_name}}",
+			text:     "<p>Hello {{.first_name}} {{.last_name}}</p>",
+			data:     map[string]string{"first_name": "John", "last_name": "Doe"},
+			wantSubj: "Welcome John",
+			wantText: "<p>Hello John Doe</p>",
+		},
+		{
+			name:     "escapes values in text",
+			mailType: config.DECISION_REJECT,
+			subject:  "{{.draft_title}}",
+			text:     "<p>{{.editor_comment}}</p>",
+			data:     map[string]string{"draft_title": "A & B", "editor_comment": "<script>alert(1)</script>"},
+			wantSubj: "A & B",
+			wantText: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>",
+		},
+		{
+			name:     "keeps html variables",
+			mailType: config.DECISION_ACCEPT,
+			subject:  "Decision",
+			text:     "<div>{{.reviewer_comments}}</div>",
+			data:     map[string]string{"reviewer_comments": "<p><b>Reviewer 1</b></p>"},
+			wantSubj: "Decision",
+			wantText: "<div><p><b>Reviewer 1</b></p></div>",
+		},
+		{
+			name:     "missing variable of the type is empty",
+			mailType: config.REVIEW_REMINDER,
+			subject:  "Due {{.due_at}}",
+			text:     "<p>{{.first_name}}</p>",
+			data:     map[string]string{},
+			wantSubj: "Due ",
+			wantText: "<p></p>",
+		},
+		{
+			name:     "unknown variable fails",
+			mailType: config.REGISTRATION,
+			subject:  "Hi",
+			text:     "{{.draft_title}}",
+			data:     map[string]string{},
+			err:      true,
+		},
+		{
+			name:     "invalid template fails",
+			mailType: config.REGISTRATION,
+			subject:  "{{",
+			text:     "Hi",
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject, text, err := RenderEmailTemplate(tt.mailType, tt.subject, tt.text, tt.data)
+			if tt.err {
+				if err == nil {
+					t.Fatalf("RenderEmailTemplate() = %q, %q, want error", subject, text)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("RenderEmailTemplate() unexpected error: %v", err)
+			}
+
+			if subject != tt.wantSubj || text != tt.wantText {
+				t.Errorf("RenderEmailTemplate() = %q, %q, want %q, %q", subject, text, tt.wantSubj, tt.wantText)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package helper
+
+import (
+	"editory_submission/config"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/mail.v2"
+)
+
+// Mail is an HTML message to a single recipient
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers the mails, the driver is chosen by config.Config.MailDriver
+type Mailer interface {
+	Send(m Mail) error
+}
+
+// NewMailer returns the driver of the config
+func NewMailer(cfg config.Config) (Mailer, error) {
+	switch cfg.MailDriver {
+	case config.MAIL_DRIVER_SMTP:
+		if cfg.SMTPHost == "" {
+			return nil, errors.New("smtp mail driver requires SMTP_HOST")
+		}
+
+		return NewSMTPMailer(cfg), nil
+	case config.MAIL_DRIVER_FILE:
+		return NewFileMailer(cfg.MailFrom, cfg.MailDir)
+	case config.MAIL_DRIVER_MEMORY:
+		return NewMemoryMailer(), nil
+	}
+
+	return nil, fmt.Errorf("unknown mail driver %q", cfg.MailDriver)
+}
+
+func newMessage(from string, m Mail) *mail.Message {
+	msg := mail.NewMessage()
+	msg.SetHeader("From", from)
+	msg.SetHeader("To", m.To)
+	msg.SetHeader("Subject", m.Subject)
+	msg.SetBody("text/html", m.Body)
+
+	return msg
+}
+
+type SMTPMailer struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	tlsMode  string
+}
+
+// NewSMTPMailer sends through the SMTP server of the config, the credentials are optional
+func NewSMTPMailer(cfg config.Config) *SMTPMailer {
+	return &SMTPMailer{
+		host:     cfg.SMTPHost,
+		port:     cfg.SMTPPort,
+		username: cfg.EmailUsername,
+		password: cfg.EmailPassword,
+		from:     cfg.MailFrom,
+		tlsMode:  cfg.SMTPTLSMode,
+	}
+}
+
+func (s *SMTPMailer) Send(m Mail) error {
+	// the dialer caches the auth of the server, so it isn't shared between the sends
+	d := mail.NewDialer(s.host, s.port, s.username, s.password)
+
+	switch s.tlsMode {
+	case config.SMTP_TLS_MODE_TLS:
+		d.SSL = true
+	case config.SMTP_TLS_MODE_STARTTLS:
+		d.SSL = false
+		d.StartTLSPolicy = mail.MandatoryStartTLS
+	case config.SMTP_TLS_MODE_NONE:
+		d.SSL = false
+		d.StartTLSPolicy = mail.NoStartTLS
+	}
+
+	return d.DialAndSend(newMessage(s.from, m))
+}
+
+type FileMailer struct {
+	from string
+	dir  string
+}
+
+// NewFileMailer writes every mail to an .eml file of the dir instead of sending it
+func NewFileMailer(from, dir string) (*FileMailer, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileMailer{
+		from: from,
+		dir:  dir,
+	}, nil
+}
+
+func (f *FileMailer) Send(m Mail) error {
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000"), uuid.NewString())
+
+	file, err := os.Create(filepath.Join(f.dir, name))
+	if err != nil {
+		return err
+	}
+
+	_, err = newMessage(f.from, m).WriteTo(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// MemoryMailer keeps the mails in memory for the tests
+type MemoryMailer struct {
+	mu    sync.Mutex
+	mails []Mail
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (mm *MemoryMailer) Send(m Mail) error {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	mm.mails = append(mm.mails, m)
+
+	return nil
+}
+
+// Mails returns the mails sent so far
+func (mm *MemoryMailer) Mails() []Mail {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+
+	return append([]Mail(nil), mm.mails...)
+}
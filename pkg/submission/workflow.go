@@ -0,0 +1,161 @@
+package submission
+
+import (
+	"editory_submission/config"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTransitionNotAllowed = errors.New("draft status transition is not allowed")
+	ErrRoleNotAllowed       = errors.New("role is not allowed to make this transition")
+	ErrRevisionReadOnly     = errors.New("draft revision is read-only, a newer revision exists")
+)
+
+// Transition describes allowed change of the draft status.
+// Step, EditorStatus and ReviewerStatus are the values the draft gets after the transition,
+// empty value means the field stays as it is
+type Transition struct {
+	From           string
+	To             string
+	Roles          []string
+	Step           string
+	EditorStatus   string
+	ReviewerStatus string
+}
+
+var (
+	authors = []string{config.AUTHOR}
+	editors = []string{config.EDITOR, config.SUPERADMIN}
+	// submitters may send a new draft to the editors, editors register the ones received outside of the system
+	submitters = []string{config.AUTHOR, config.EDITOR, config.SUPERADMIN}
+)
+
+// transitions with empty From are the statuses a draft can be created with,
+// a draft is published only after it is CONFIRMED
+var transitions = []Transition{
+	{From: "", To: config.ARTICLE_STATUS_DRAFT, Roles: authors, Step: config.DRAFT_STEP_AUTHOR},
+	{From: "", To: config.ARTICLE_STATUS_NEW, Roles: submitters, Step: config.DRAFT_STEP_EDITOR, EditorStatus: config.DRAFT_CHECK_STATUS_NEW},
+
+	{From: config.ARTICLE_STATUS_DRAFT, To: config.ARTICLE_STATUS_NEW, Roles: authors, Step: config.DRAFT_STEP_EDITOR, EditorStatus: config.DRAFT_CHECK_STATUS_NEW},
+
+	{From: config.ARTICLE_STATUS_NEW, To: config.ARTICLE_STATUS_PENDING, Roles: editors, Step: config.DRAFT_STEP_EDITOR, EditorStatus: config.DRAFT_CHECK_STATUS_PENDING},
+	{From: config.ARTICLE_STATUS_CORRECTED, To: config.ARTICLE_STATUS_PENDING, Roles: editors, Step: config.DRAFT_STEP_EDITOR, EditorStatus: config.DRAFT_CHECK_STATUS_PENDING},
+
+	{From: config.ARTICLE_STATUS_NEW, To: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+	{From: config.ARTICLE_STATUS_PENDING, To: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+	{From: config.ARTICLE_STATUS_CORRECTED, To: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+
+	{From: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, To: config.ARTICLE_STATUS_CORRECTED, Roles: authors, Step: config.DRAFT_STEP_EDITOR, EditorStatus: config.DRAFT_CHECK_STATUS_NEW},
+
+	{From: config.ARTICLE_STATUS_NEW, To: config.ARTICLE_STATUS_CONFIRMED, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+	{From: config.ARTICLE_STATUS_PENDING, To: config.ARTICLE_STATUS_CONFIRMED, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+	{From: config.ARTICLE_STATUS_CORRECTED, To: config.ARTICLE_STATUS_CONFIRMED, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+
+	{From: config.ARTICLE_STATUS_NEW, To: config.ARTICLE_STATUS_DENIED, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+	{From: config.ARTICLE_STATUS_PENDING, To: config.ARTICLE_STATUS_DENIED, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+	{From: config.ARTICLE_STATUS_CORRECTED, To: config.ARTICLE_STATUS_DENIED, Roles: editors, Step: config.DRAFT_STEP_AUTHOR, EditorStatus: config.DRAFT_CHECK_STATUS_DONE},
+
+	{From: config.ARTICLE_STATUS_CONFIRMED, To: config.ARTICLE_STATUS_PUBLISHED, Roles: editors, Step: config.DRAFT_STEP_AUTHOR},
+}
+
+// reviewerAssignment is applied when editor sends the draft to a reviewer
+var reviewerAssignment = Transition{
+	To:             config.ARTICLE_STATUS_PENDING,
+	Roles:          editors,
+	Step:           config.DRAFT_STEP_REVIEWER,
+	EditorStatus:   config.DRAFT_CHECK_STATUS_PENDING,
+	ReviewerStatus: config.DRAFT_CHECK_STATUS_PENDING,
+}
+
+// revisionSubmission is applied to the new revision of the draft sent back for correction,
+// the author attaches corrected files to it and moves it to CORRECTED
+var revisionSubmission = Transition{
+	From:  config.ARTICLE_STATUS_BACK_FOR_CORRECTION,
+	To:    config.ARTICLE_STATUS_BACK_FOR_CORRECTION,
+	Roles: authors,
+	Step:  config.DRAFT_STEP_AUTHOR,
+}
+
+// reviewableStatuses are the statuses in which draft can be sent to a reviewer
+var reviewableStatuses = map[string]bool{
+	config.ARTICLE_STATUS_NEW:       true,
+	config.ARTICLE_STATUS_PENDING:   true,
+	config.ARTICLE_STATUS_CORRECTED: true,
+}
+
+// editorDecisions maps editor checker status to the draft status it leads to
+var editorDecisions = map[string]string{
+	config.ARTICLE_EDITOR_STATUS_NEW:                      config.ARTICLE_STATUS_PENDING,
+	config.ARTICLE_EDITOR_STATUS_PENDING:                  config.ARTICLE_STATUS_PENDING,
+	config.ARTICLE_EDITOR_STATUS_APPROVED:                 config.ARTICLE_STATUS_CONFIRMED,
+	config.ARTICLE_EDITOR_STATUS_REJECTED:                 config.ARTICLE_STATUS_DENIED,
+	config.ARTICLE_EDITOR_STATUS_APPROVED_WITH_CORRECTION: config.ARTICLE_STATUS_BACK_FOR_CORRECTION,
+	config.ARTICLE_EDITOR_STATUS_REJECTED_WITH_CORRECTION: config.ARTICLE_STATUS_BACK_FOR_CORRECTION,
+}
+
+// Transit returns the transition of the draft from one status to another if the role may trigger it.
+// Use empty from for a newly created draft
+func Transit(from, to, roleType string) (*Transition, error) {
+	for i := range transitions {
+		t := transitions[i]
+		if t.From != from || t.To != to {
+			continue
+		}
+
+		if !t.allows(roleType) {
+			return nil, fmt.Errorf("%w: %s can't move draft from %q to %q", ErrRoleNotAllowed, roleType, from, to)
+		}
+
+		return &t, nil
+	}
+
+	return nil, fmt.Errorf("%w: from %q to %q", ErrTransitionNotAllowed, from, to)
+}
+
+// AssignReviewer returns the transition of the draft which is sent to a reviewer
+func AssignReviewer(from, roleType string) (*Transition, error) {
+	if !reviewableStatuses[from] {
+		return nil, fmt.Errorf("%w: can't assign reviewer to %q draft", ErrTransitionNotAllowed, from)
+	}
+
+	t := reviewerAssignment
+	t.From = from
+
+	if !t.allows(roleType) {
+		return nil, fmt.Errorf("%w: %s can't assign reviewer", ErrRoleNotAllowed, roleType)
+	}
+
+	return &t, nil
+}
+
+// SubmitRevision returns the transition of the new revision created from the draft
+func SubmitRevision(from, roleType string) (*Transition, error) {
+	if from != revisionSubmission.From {
+		return nil, fmt.Errorf("%w: can't submit revision of %q draft", ErrTransitionNotAllowed, from)
+	}
+
+	t := revisionSubmission
+
+	if !t.allows(roleType) {
+		return nil, fmt.Errorf("%w: %s can't submit revision", ErrRoleNotAllowed, roleType)
+	}
+
+	return &t, nil
+}
+
+// EditorDecision returns the draft status which follows the editor checker status
+func EditorDecision(checkerStatus string) (string, bool) {
+	status, ok := editorDecisions[checkerStatus]
+	return status, ok
+}
+
+func (t *Transition) allows(roleType string) bool {
+	for _, r := range t.Roles {
+		if r == roleType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,137 @@
+package submission
+
+import (
+	"editory_submission/config"
+	"errors"
+	"testing"
+)
+
+func TestTransit(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		roleType string
+		step     string
+		err      error
+	}{
+		{name: "author creates draft", from: "", to: config.ARTICLE_STATUS_DRAFT, roleType: config.AUTHOR, step: config.DRAFT_STEP_AUTHOR},
+		{name: "editor can't create draft", from: "", to: config.ARTICLE_STATUS_DRAFT, roleType: config.EDITOR, err: ErrRoleNotAllowed},
+		{name: "author submits new draft", from: "", to: config.ARTICLE_STATUS_NEW, roleType: config.AUTHOR, step: config.DRAFT_STEP_EDITOR},
+		{name: "editor registers new draft", from: "", to: config.ARTICLE_STATUS_NEW, roleType: config.EDITOR, step: config.DRAFT_STEP_EDITOR},
+		{name: "reviewer can't create draft", from: "", to: config.ARTICLE_STATUS_NEW, roleType: config.REVIEWER, err: ErrRoleNotAllowed},
+		{name: "draft can't be created published", from: "", to: config.ARTICLE_STATUS_PUBLISHED, roleType: config.SUPERADMIN, err: ErrTransitionNotAllowed},
+		{name: "author sends draft", from: config.ARTICLE_STATUS_DRAFT, to: config.ARTICLE_STATUS_NEW, roleType: config.AUTHOR, step: config.DRAFT_STEP_EDITOR},
+		{name: "editor confirms new draft", from: config.ARTICLE_STATUS_NEW, to: config.ARTICLE_STATUS_CONFIRMED, roleType: config.EDITOR, step: config.DRAFT_STEP_AUTHOR},
+		{name: "author can't confirm draft", from: config.ARTICLE_STATUS_NEW, to: config.ARTICLE_STATUS_CONFIRMED, roleType: config.AUTHOR, err: ErrRoleNotAllowed},
+		{name: "author corrects draft", from: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, to: config.ARTICLE_STATUS_CORRECTED, roleType: config.AUTHOR, step: config.DRAFT_STEP_EDITOR},
+		{name: "superadmin publishes confirmed draft", from: config.ARTICLE_STATUS_CONFIRMED, to: config.ARTICLE_STATUS_PUBLISHED, roleType: config.SUPERADMIN, step: config.DRAFT_STEP_AUTHOR},
+		{name: "new draft can't be published", from: config.ARTICLE_STATUS_NEW, to: config.ARTICLE_STATUS_PUBLISHED, roleType: config.EDITOR, err: ErrTransitionNotAllowed},
+		{name: "denied draft can't be reopened", from: config.ARTICLE_STATUS_DENIED, to: config.ARTICLE_STATUS_PENDING, roleType: config.EDITOR, err: ErrTransitionNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := Transit(tt.from, tt.to, tt.roleType)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Transit() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Transit() unexpected error: %v", err)
+			}
+
+			if tr.From != tt.from || tr.To != tt.to || tr.Step != tt.step {
+				t.Errorf("Transit() = %+v, want from %q to %q at step %q", tr, tt.from, tt.to, tt.step)
+			}
+		})
+	}
+}
+
+func TestAssignReviewer(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		roleType string
+		err      error
+	}{
+		{name: "editor assigns to new draft", from: config.ARTICLE_STATUS_NEW, roleType: config.EDITOR},
+		{name: "superadmin assigns to corrected draft", from: config.ARTICLE_STATUS_CORRECTED, roleType: config.SUPERADMIN},
+		{name: "author can't assign", from: config.ARTICLE_STATUS_PENDING, roleType: config.AUTHOR, err: ErrRoleNotAllowed},
+		{name: "reviewer can't assign", from: config.ARTICLE_STATUS_PENDING, roleType: config.REVIEWER, err: ErrRoleNotAllowed},
+		{name: "confirmed draft isn't reviewable", from: config.ARTICLE_STATUS_CONFIRMED, roleType: config.EDITOR, err: ErrTransitionNotAllowed},
+		{name: "unsent draft isn't reviewable", from: config.ARTICLE_STATUS_DRAFT, roleType: config.EDITOR, err: ErrTransitionNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr, err := AssignReviewer(tt.from, tt.roleType)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("AssignReviewer() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AssignReviewer() unexpected error: %v", err)
+			}
+
+			if tr.From != tt.from || tr.To != config.ARTICLE_STATUS_PENDING || tr.Step != config.DRAFT_STEP_REVIEWER {
+				t.Errorf("AssignReviewer() = %+v", tr)
+			}
+		})
+	}
+}
+
+func TestSubmitRevision(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		roleType string
+		err      error
+	}{
+		{name: "author submits revision", from: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, roleType: config.AUTHOR},
+		{name: "editor can't submit revision", from: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, roleType: config.EDITOR, err: ErrRoleNotAllowed},
+		{name: "pending draft has no revision", from: config.ARTICLE_STATUS_PENDING, roleType: config.AUTHOR, err: ErrTransitionNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SubmitRevision(tt.from, tt.roleType)
+			if tt.err == nil && err != nil {
+				t.Fatalf("SubmitRevision() unexpected error: %v", err)
+			}
+
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("SubmitRevision() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestEditorDecision(t *testing.T) {
+	tests := []struct {
+		checkerStatus string
+		status        string
+		ok            bool
+	}{
+		{checkerStatus: config.ARTICLE_EDITOR_STATUS_APPROVED, status: config.ARTICLE_STATUS_CONFIRMED, ok: true},
+		{checkerStatus: config.ARTICLE_EDITOR_STATUS_REJECTED, status: config.ARTICLE_STATUS_DENIED, ok: true},
+		{checkerStatus: config.ARTICLE_EDITOR_STATUS_APPROVED_WITH_CORRECTION, status: config.ARTICLE_STATUS_BACK_FOR_CORRECTION, ok: true},
+		{checkerStatus: config.ARTICLE_EDITOR_STATUS_PENDING, status: config.ARTICLE_STATUS_PENDING, ok: true},
+		{checkerStatus: "UNKNOWN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.checkerStatus, func(t *testing.T) {
+			status, ok := EditorDecision(tt.checkerStatus)
+			if status != tt.status || ok != tt.ok {
+				t.Errorf("EditorDecision(%q) = %q, %v, want %q, %v", tt.checkerStatus, status, ok, tt.status, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package util
+
+import "editory_submission/config"
+
+// IsValidLanguage checks the language of the mails and the templates
+func IsValidLanguage(language string) bool {
+	switch language {
+	case config.LANGUAGE_EN, config.LANGUAGE_RU, config.LANGUAGE_UZ:
+		return true
+	}
+
+	return false
+}
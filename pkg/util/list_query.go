@@ -0,0 +1,110 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// ErrInvalidFilter is returned for the list filters the clients sent with invalid values
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// SortColumns maps the sort keys accepted from the clients to the SQL columns
+type SortColumns map[string]string
+
+// OrderBy builds the ORDER BY clause of a sort like "created_at:desc,title:asc", the fallback is returned for an empty sort.
+// Keys missing from the columns and unknown directions return ErrInvalidFilter
+func OrderBy(sort string, columns SortColumns, fallback string) (string, error) {
+	if strings.TrimSpace(sort) == "" {
+		return fallback, nil
+	}
+
+	var items []string
+
+	for _, item := range strings.Split(sort, ",") {
+		key, direction, _ := strings.Cut(strings.TrimSpace(item), ":")
+
+		column, ok := columns[key]
+		if !ok {
+			return "", fmt.Errorf("%w: sort key %q is not valid", ErrInvalidFilter, key)
+		}
+
+		switch strings.ToLower(direction) {
+		case "", "asc":
+			items = append(items, column+" ASC")
+		case "desc":
+			items = append(items, column+" DESC")
+		default:
+			return "", fmt.Errorf("%w: sort direction %q is not valid", ErrInvalidFilter, direction)
+		}
+	}
+
+	return " ORDER BY " + strings.Join(items, ", "), nil
+}
+
+// CreatedRange builds the filter of the created_from and created_to range on the column.
+// The bounds are RFC3339 timestamps or dates, a created_to date includes the whole day.
+// Timestamps are compared in UTC, the columns are timestamps without time zone written in UTC
+func CreatedRange(column, from, to string, params map[string]interface{}) (filter string, err error) {
+	if from != "" {
+		t, ok := parseDate(from)
+		if !ok {
+			return "", fmt.Errorf("%w: created_from %q is not an RFC3339 time or a date", ErrInvalidFilter, from)
+		}
+
+		params["created_from"] = t.UTC().Format(time.RFC3339)
+		filter += ` AND ` + column + ` >= :created_from::TIMESTAMP`
+	}
+
+	if to != "" {
+		if t, err := time.Parse(dateLayout, to); err == nil {
+			params["created_to"] = t.AddDate(0, 0, 1).Format(time.RFC3339)
+			filter += ` AND ` + column + ` < :created_to::TIMESTAMP`
+		} else if t, err := time.Parse(time.RFC3339, to); err == nil {
+			params["created_to"] = t.UTC().Format(time.RFC3339)
+			filter += ` AND ` + column + ` <= :created_to::TIMESTAMP`
+		} else {
+			return "", fmt.Errorf("%w: created_to %q is not an RFC3339 time or a date", ErrInvalidFilter, to)
+		}
+	}
+
+	return filter, nil
+}
+
+// AnyOf builds the filter matching the column with one of the comma separated values like "NEW,PENDING".
+// Nothing is filtered for empty values, a value missing from the whitelist is an error
+func AnyOf(column, param, values string, valid map[string]bool, params map[string]interface{}) (string, error) {
+	if strings.TrimSpace(values) == "" {
+		return "", nil
+	}
+
+	var items []string
+
+	for _, value := range strings.Split(values, ",") {
+		value = strings.TrimSpace(value)
+		if !valid[value] {
+			return "", fmt.Errorf("%w: %s %q is not valid", ErrInvalidFilter, param, value)
+		}
+
+		items = append(items, value)
+	}
+
+	params[param] = items
+
+	return ` AND ` + column + `::VARCHAR = ANY(:` + param + `)`, nil
+}
+
+func parseDate(value string) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, true
+	}
+
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t, true
+	}
+
+	return time.Time{}, false
+}
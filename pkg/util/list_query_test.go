@@ -0,0 +1,144 @@
+package util
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOrderBy(t *testing.T) {
+	columns := SortColumns{
+		"created_at": "created_at",
+		"title":      "title",
+	}
+	fallback := " ORDER BY created_at DESC"
+
+	tests := []struct {
+		name string
+		sort string
+		want string
+		err  error
+	}{
+		{name: "empty", sort: "", want: fallback},
+		{name: "default direction", sort: "title", want: " ORDER BY title ASC"},
+		{name: "several keys", sort: "created_at:desc, title:ASC", want: " ORDER BY created_at DESC, title ASC"},
+		{name: "unknown key", sort: "password:asc,title:desc", err: ErrInvalidFilter},
+		{name: "unknown direction", sort: "title:sideways", err: ErrInvalidFilter},
+		{name: "empty item", sort: "title,", err: ErrInvalidFilter},
+		{name: "injection", sort: "title; DROP TABLE users", err: ErrInvalidFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OrderBy(tt.sort, columns, fallback)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("OrderBy(%q) error = %v, want %v", tt.sort, err, tt.err)
+			}
+
+			if got != tt.want {
+				t.Errorf("OrderBy(%q) = %q, want %q", tt.sort, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyOf(t *testing.T) {
+	valid := map[string]bool{"NEW": true, "PENDING": true}
+
+	tests := []struct {
+		name   string
+		values string
+		want   string
+		items  []string
+		err    error
+	}{
+		{name: "empty", values: "", want: ""},
+		{name: "blank", values: "  ", want: ""},
+		{name: "one value", values: "NEW", want: ` AND status::VARCHAR = ANY(:status)`, items: []string{"NEW"}},
+		{name: "several values", values: "NEW, PENDING", want: ` AND status::VARCHAR = ANY(:status)`, items: []string{"NEW", "PENDING"}},
+		{name: "unknown value", values: "NEW,DONE", err: ErrInvalidFilter},
+		{name: "empty item", values: "NEW,", err: ErrInvalidFilter},
+		{name: "lower case", values: "new", err: ErrInvalidFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]interface{}{}
+
+			got, err := AnyOf("status", "status", tt.values, valid, params)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("AnyOf() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AnyOf() unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("AnyOf() = %q, want %q", got, tt.want)
+			}
+
+			if tt.items != nil && !reflect.DeepEqual(params["status"], tt.items) {
+				t.Errorf("AnyOf() params = %v, want %v", params["status"], tt.items)
+			}
+		})
+	}
+}
+
+func TestCreatedRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		want   string
+		params map[string]interface{}
+		err    error
+	}{
+		{name: "no bounds", want: "", params: map[string]interface{}{}},
+		{
+			name:   "dates",
+			from:   "2024-03-01",
+			to:     "2024-03-31",
+			want:   ` AND created_at >= :created_from::TIMESTAMP AND created_at < :created_to::TIMESTAMP`,
+			params: map[string]interface{}{"created_from": "2024-03-01T00:00:00Z", "created_to": "2024-04-01T00:00:00Z"},
+		},
+		{
+			name:   "timestamps converted to utc",
+			from:   "2024-03-01T10:00:00+05:00",
+			to:     "2024-03-01T23:30:00-02:00",
+			want:   ` AND created_at >= :created_from::TIMESTAMP AND created_at <= :created_to::TIMESTAMP`,
+			params: map[string]interface{}{"created_from": "2024-03-01T05:00:00Z", "created_to": "2024-03-02T01:30:00Z"},
+		},
+		{name: "invalid from", from: "last week", err: ErrInvalidFilter},
+		{name: "invalid to", to: "2024-13-01", err: ErrInvalidFilter},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]interface{}{}
+
+			got, err := CreatedRange("created_at", tt.from, tt.to, params)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("CreatedRange() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("CreatedRange() unexpected error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("CreatedRange() = %q, want %q", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(params, tt.params) {
+				t.Errorf("CreatedRange() params = %v, want %v", params, tt.params)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package analytics
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/content_service"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+)
+
+// periodLayout formats the start of the period
+const periodLayout = `'YYYY-MM-DD'`
+
+type analyticsRepo struct {
+	db models.DB
+}
+
+func NewAnalyticsRepo(db models.DB) storage.AnalyticsRepoI {
+	return &analyticsRepo{
+		db: db,
+	}
+}
+
+// GetPlatformStats aggregates the platform activity created from dateFrom inclusive to dateTo exclusive.
+// The granularity is the date_trunc field the periods are truncated to
+func (r *analyticsRepo) GetPlatformStats(ctx context.Context, dateFrom, dateTo, granularity string) (res *pb.PlatformStats, err error) {
+	res = &pb.PlatformStats{}
+
+	res.Registrations, err = r.getRegistrations(ctx, dateFrom, dateTo, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT
+		count(1) FILTER (WHERE email_verification),
+		count(1) FILTER (WHERE NOT COALESCE(email_verification, FALSE))
+	FROM "user"
+	WHERE created_at >= $1::TIMESTAMP AND created_at < $2::TIMESTAMP`
+
+	err = r.db.QueryRow(ctx, query, dateFrom, dateTo).Scan(
+		&res.VerifiedUsers,
+		&res.UnverifiedUsers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Drafts, err = r.getDrafts(ctx, dateFrom, dateTo, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	res.PublishedArticles, err = r.getPublishedArticles(ctx, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Notifications, err = r.getNotifications(ctx, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	// FAILED notifications are still retried by the outbox, DEAD ones are given up
+	var sent, dead int32
+	for _, item := range res.Notifications {
+		switch item.Status {
+		case config.EMAIL_STATUS_SENT:
+			sent = item.Count
+		case config.EMAIL_STATUS_DEAD:
+			dead = item.Count
+		}
+	}
+
+	if sent+dead > 0 {
+		res.NotificationSuccessRate = float64(sent) * 100 / float64(sent+dead)
+	}
+
+	return res, nil
+}
+
+// getRegistrations counts the new users of every period, the periods without registrations are kept
+func (r *analyticsRepo) getRegistrations(ctx context.Context, dateFrom, dateTo, granularity string) (res []*pb.PeriodCount, err error) {
+	query := `SELECT
+		TO_CHAR(p.period, ` + periodLayout + `),
+		count(u.id)
+	FROM generate_series(
+		date_trunc($3, $1::TIMESTAMP),
+		$2::TIMESTAMP - INTERVAL '1 microsecond',
+		('1 ' || $3)::INTERVAL
+	) p(period)
+	LEFT JOIN "user" u ON date_trunc($3, u.created_at) = p.period
+		AND u.created_at >= $1::TIMESTAMP AND u.created_at < $2::TIMESTAMP
+	GROUP BY p.period
+	ORDER BY p.period`
+
+	rows, err := r.db.Query(ctx, query, dateFrom, dateTo, granularity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.PeriodCount{}
+
+		err = rows.Scan(
+			&obj.Period,
+			&obj.Count,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
+
+// getDrafts counts the submissions of the journals by the period of their first revision
+func (r *analyticsRepo) getDrafts(ctx context.Context, dateFrom, dateTo, granularity string) (res []*pb.JournalPeriodCount, err error) {
+	query := `WITH submission AS (
+		SELECT
+			journal_id,
+			MIN(created_at) AS created_at
+		FROM "draft"
+		GROUP BY COALESCE(group_id, id), journal_id
+	)
+	SELECT
+		COALESCE(s.journal_id::VARCHAR, ''),
+		COALESCE(j.title, ''),
+		TO_CHAR(date_trunc($3, s.created_at), ` + periodLayout + `) AS period,
+		count(1)
+	FROM submission s
+	LEFT JOIN "journal" j ON j.id = s.journal_id
+	WHERE s.created_at >= $1::TIMESTAMP AND s.created_at < $2::TIMESTAMP
+	GROUP BY 1, 2, 3
+	ORDER BY 3, 2`
+
+	rows, err := r.db.Query(ctx, query, dateFrom, dateTo, granularity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.JournalPeriodCount{}
+
+		err = rows.Scan(
+			&obj.JournalId,
+			&obj.JournalTitle,
+			&obj.Period,
+			&obj.Count,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
+
+// getPublishedArticles counts the articles published to every edition of the journals
+func (r *analyticsRepo) getPublishedArticles(ctx context.Context, dateFrom, dateTo string) (res []*pb.EditionCount, err error) {
+	query := `SELECT
+		COALESCE(a.journal_id::VARCHAR, ''),
+		COALESCE(j.title, ''),
+		a.edition,
+		count(1)
+	FROM "article" a
+	LEFT JOIN "journal" j ON j.id = a.journal_id
+	WHERE a.created_at >= $1::TIMESTAMP AND a.created_at < $2::TIMESTAMP
+	GROUP BY 1, 2, 3
+	ORDER BY 2, 3`
+
+	rows, err := r.db.Query(ctx, query, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.EditionCount{}
+
+		err = rows.Scan(
+			&obj.JournalId,
+			&obj.JournalTitle,
+			&obj.Edition,
+			&obj.Count,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
+
+// getNotifications counts the notifications by the delivery status
+func (r *analyticsRepo) getNotifications(ctx context.Context, dateFrom, dateTo string) (res []*pb.NotificationStatusCount, err error) {
+	query := `SELECT
+		COALESCE(status::VARCHAR, ''),
+		count(1)
+	FROM "notification"
+	WHERE created_at >= $1::TIMESTAMP AND created_at < $2::TIMESTAMP
+	GROUP BY 1
+	ORDER BY 1`
+
+	rows, err := r.db.Query(ctx, query, dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.NotificationStatusCount{}
+
+		err = rows.Scan(
+			&obj.Status,
+			&obj.Count,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
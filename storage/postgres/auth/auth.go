@@ -2,19 +2,19 @@ package auth
 
 import (
 	"editory_submission/storage"
-
-	"github.com/jackc/pgx/v4/pgxpool"
+	"editory_submission/storage/postgres/models"
 )
 
 type authRepo struct {
-	db      *pgxpool.Pool
-	user    storage.UserRepoI
-	session storage.SessionRepoI
-	role    storage.RoleRepoI
-	keyword storage.KeywordRepoI
+	db         models.DB
+	user       storage.UserRepoI
+	session    storage.SessionRepoI
+	role       storage.RoleRepoI
+	keyword    storage.KeywordRepoI
+	permission storage.PermissionRepoI
 }
 
-func NewAuthRepo(db *pgxpool.Pool) storage.AuthRepoI {
+func NewAuthRepo(db models.DB) storage.AuthRepoI {
 	return &authRepo{
 		db: db,
 	}
@@ -51,3 +51,11 @@ func (s *authRepo) Keyword() storage.KeywordRepoI {
 
 	return s.keyword
 }
+
+func (s *authRepo) Permission() storage.PermissionRepoI {
+	if s.permission == nil {
+		s.permission = NewPermissionRepo(s.db)
+	}
+
+	return s.permission
+}
@@ -4,16 +4,18 @@ import (
 	"context"
 	pb "editory_submission/genproto/auth_service"
 	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
+	"strings"
 )
 
 type KeywordRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewKeywordRepo(db *pgxpool.Pool) storage.KeywordRepoI {
+func NewKeywordRepo(db models.DB) storage.KeywordRepoI {
 	return &KeywordRepo{
 		db: db,
 	}
@@ -83,6 +85,10 @@ func (s *KeywordRepo) GetList(ctx context.Context, req *pb.GetKeywordListReq) (r
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"word": "word",
+	}
+
 	query := `SELECT
 		id,           
     	word
@@ -120,7 +126,12 @@ func (s *KeywordRepo) GetList(ctx context.Context, req *pb.GetKeywordListReq) (r
 		return res, err
 	}
 
-	q := query + filter + offset + limit
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
@@ -185,3 +196,46 @@ func (s *KeywordRepo) Delete(ctx context.Context, req *pb.DeleteKeywordReq) (row
 
 	return rowsAffected, err
 }
+
+// GetOrCreate resolves keyword ids or words to ids, missing words are created
+func (s *KeywordRepo) GetOrCreate(ctx context.Context, keywords []string) (ids []string, err error) {
+	query := `INSERT INTO "keyword" (
+		id,
+		word
+	) VALUES (
+		$1,
+		$2
+	) ON CONFLICT (word) DO UPDATE SET word = EXCLUDED.word
+	RETURNING id`
+
+	ids = []string{}
+	added := make(map[string]bool)
+
+	for _, val := range keywords {
+		val = strings.TrimSpace(val)
+		if val == "" {
+			continue
+		}
+
+		if !util.IsValidUUID(val) {
+			newId, err := uuid.NewRandom()
+			if err != nil {
+				return nil, err
+			}
+
+			err = s.db.QueryRow(ctx, query, newId.String(), val).Scan(&val)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if added[val] {
+			continue
+		}
+		added[val] = true
+
+		ids = append(ids, val)
+	}
+
+	return ids, nil
+}
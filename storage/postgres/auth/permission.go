@@ -0,0 +1,277 @@
+package auth
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/auth_service"
+	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"github.com/google/uuid"
+)
+
+type PermissionRepo struct {
+	db models.DB
+}
+
+func NewPermissionRepo(db models.DB) storage.PermissionRepoI {
+	return &PermissionRepo{
+		db: db,
+	}
+}
+
+func (s *PermissionRepo) Create(ctx context.Context, req *pb.CreatePermissionReq) (res *pb.Permission, err error) {
+	res = &pb.Permission{}
+
+	query := `INSERT INTO "permission" (
+		id,
+		role_type,
+		path,
+		method
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4
+	) RETURNING
+		id,
+		role_type,
+		path,
+		method,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at`
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.QueryRow(ctx, query,
+		id.String(),
+		req.GetRoleType(),
+		req.GetPath(),
+		req.GetMethod(),
+	).Scan(
+		&res.Id,
+		&res.RoleType,
+		&res.Path,
+		&res.Method,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *PermissionRepo) Get(ctx context.Context, req *pb.GetPermissionReq) (res *pb.Permission, err error) {
+	res = &pb.Permission{}
+
+	query := `SELECT
+		id,
+		role_type,
+		path,
+		method,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+	FROM
+		"permission"
+	WHERE
+		id = $1`
+
+	err = s.db.QueryRow(
+		ctx,
+		query,
+		req.GetId()).Scan(
+		&res.Id,
+		&res.RoleType,
+		&res.Path,
+		&res.Method,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (s *PermissionRepo) GetList(ctx context.Context, req *pb.GetPermissionListReq) (res *pb.GetPermissionListRes, err error) {
+	res = &pb.GetPermissionListRes{}
+	params := make(map[string]interface{})
+	var arr []interface{}
+
+	sortColumns := util.SortColumns{
+		"role_type":  "role_type",
+		"path":       "path",
+		"method":     "method",
+		"created_at": "created_at",
+	}
+
+	query := `SELECT
+		id,
+		role_type,
+		path,
+		method,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+	FROM
+		"permission"`
+	filter := " WHERE 1=1"
+
+	order, err := util.OrderBy(req.GetSort(), sortColumns, " ORDER BY role_type, path")
+	if err != nil {
+		return res, err
+	}
+
+	offset := " OFFSET 0"
+
+	limit := " LIMIT 10"
+
+	if len(req.GetSearch()) > 0 {
+		params["search"] = req.GetSearch()
+		filter += ` AND (path ILIKE '%' || :search || '%')`
+	}
+
+	if len(req.GetRoleType()) > 0 {
+		params["role_type"] = req.GetRoleType()
+		filter += ` AND role_type = :role_type`
+	}
+
+	if req.GetOffset() > 0 {
+		params["offset"] = req.GetOffset()
+		offset = " OFFSET :offset"
+	}
+
+	if req.GetLimit() > 0 {
+		params["limit"] = req.GetLimit()
+		limit = " LIMIT :limit"
+	}
+
+	createdFilter, err := util.CreatedRange("created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
+	cQ := `SELECT count(1) FROM "permission"` + filter
+
+	cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+		&res.Count,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
+
+	q, arr = helper.ReplaceQueryParams(q, params)
+	rows, err := s.db.Query(ctx, q, arr...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.Permission{}
+
+		err = rows.Scan(
+			&obj.Id,
+			&obj.RoleType,
+			&obj.Path,
+			&obj.Method,
+			&obj.CreatedAt,
+			&obj.UpdatedAt,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		res.Permissions = append(res.Permissions, obj)
+	}
+
+	return res, nil
+}
+
+func (s *PermissionRepo) Update(ctx context.Context, req *pb.Permission) (res *pb.Permission, err error) {
+	res = &pb.Permission{}
+
+	query := `UPDATE "permission" SET
+		role_type = :role_type,
+		path = :path,
+		method = :method,
+		updated_at = now()
+	WHERE
+		id = :id
+	RETURNING
+		id,
+		role_type,
+		path,
+		method,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at`
+
+	params := map[string]interface{}{
+		"id":        req.GetId(),
+		"role_type": req.GetRoleType(),
+		"path":      req.GetPath(),
+		"method":    req.GetMethod(),
+	}
+
+	q, arr := helper.ReplaceQueryParams(query, params)
+	err = s.db.QueryRow(ctx, q, arr...).Scan(
+		&res.Id,
+		&res.RoleType,
+		&res.Path,
+		&res.Method,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *PermissionRepo) Delete(ctx context.Context, req *pb.DeletePermissionReq) (rowsAffected int64, err error) {
+	query := `DELETE FROM "permission" WHERE id = $1`
+
+	result, err := s.db.Exec(ctx, query, req.GetId())
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected = result.RowsAffected()
+
+	return rowsAffected, err
+}
+
+// HasAccess checks whether any scope of the role type matches the path and method.
+// Scope path may end with /* to match the path itself and every nested path, method * matches every method.
+// _ and % of the scope are escaped, so only * is a wildcard
+func (s *PermissionRepo) HasAccess(ctx context.Context, roleType, path, method string) (hasAccess bool, err error) {
+	query := `SELECT EXISTS (
+		SELECT 1 FROM "permission"
+		WHERE
+			role_type = $1 AND
+			(
+				$2 LIKE replace(replace(replace(replace(path, '\', '\\'), '_', '\_'), '%', '\%'), '*', '%') OR
+				(path LIKE '%/*' AND $2 = left(path, -2))
+			) AND
+			(method = '*' OR method = $3)
+	)`
+
+	err = s.db.QueryRow(ctx, query, roleType, path, method).Scan(&hasAccess)
+	if err != nil {
+		return false, err
+	}
+
+	return hasAccess, nil
+}
@@ -6,16 +6,16 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"fmt"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type RoleRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewRoleRepo(db *pgxpool.Pool) storage.RoleRepoI {
+func NewRoleRepo(db models.DB) storage.RoleRepoI {
 	return &RoleRepo{
 		db: db,
 	}
@@ -91,6 +91,11 @@ func (s *RoleRepo) GetList(ctx context.Context, req *pb.GetRoleListReq) (res *pb
 	res = &pb.GetRoleListRes{}
 	params := make(map[string]interface{})
 	var arr []interface{}
+
+	sortColumns := util.SortColumns{
+		"role_type": "role_type",
+	}
+
 	fmt.Println("req", req)
 
 	query := `SELECT
@@ -149,7 +154,12 @@ func (s *RoleRepo) GetList(ctx context.Context, req *pb.GetRoleListReq) (res *pb
 		return res, err
 	}
 
-	q := query + filter + offset + limit
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
@@ -7,16 +7,16 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"time"
 )
 
 type sessionRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewSessionRepo(db *pgxpool.Pool) storage.SessionRepoI {
+func NewSessionRepo(db models.DB) storage.SessionRepoI {
 	return &sessionRepo{
 		db: db,
 	}
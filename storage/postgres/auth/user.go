@@ -14,15 +14,13 @@ import (
 	"github.com/jackc/pgx/v4"
 	"strings"
 	"time"
-
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type UserRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewUserRepo(db *pgxpool.Pool) storage.UserRepoI {
+func NewUserRepo(db models.DB) storage.UserRepoI {
 	return &UserRepo{
 		db: db,
 	}
@@ -48,7 +46,8 @@ func (s *UserRepo) Create(ctx context.Context, req *pb.User) (res *pb.User, err
     	post_code,
         gender,
         is_completed,
-        university_id
+        university_id,
+        preferred_language
 	) VALUES (
 		$1,
 		$2,
@@ -66,7 +65,8 @@ func (s *UserRepo) Create(ctx context.Context, req *pb.User) (res *pb.User, err
 		$14,
 		$15,
 		$16,
-		$17
+		$17,
+		$18
 	)`
 
 	id, err := uuid.NewRandom()
@@ -92,6 +92,7 @@ func (s *UserRepo) Create(ctx context.Context, req *pb.User) (res *pb.User, err
 		util.NewNullString(req.GetGender()),
 		req.GetIsCompleted(),
 		util.NewNullString(req.UniversityId),
+		util.NewNullString(req.GetPreferredLanguage()),
 	)
 
 	res = &pb.User{
@@ -112,6 +113,7 @@ func (s *UserRepo) Create(ctx context.Context, req *pb.User) (res *pb.User, err
 		PostCode:          req.GetPostCode(),
 		Gender:            req.GetGender(),
 		UniversityId:      req.UniversityId,
+		PreferredLanguage: req.GetPreferredLanguage(),
 	}
 
 	return res, err
@@ -136,7 +138,8 @@ func (s *UserRepo) Get(ctx context.Context, req *pb.GetUserReq) (res *pb.User, e
     	COALESCE(address, ''),            
     	COALESCE(post_code, ''), 
     	COALESCE(gender::VARCHAR, ''),
-    	COALESCE(university_id::VARCHAR, '')
+    	COALESCE(university_id::VARCHAR, ''),
+    	COALESCE(preferred_language::VARCHAR, '')
 	FROM
 		"user"
 	WHERE
@@ -163,6 +166,7 @@ func (s *UserRepo) Get(ctx context.Context, req *pb.GetUserReq) (res *pb.User, e
 		&res.PostCode,
 		&res.Gender,
 		&res.UniversityId,
+		&res.PreferredLanguage,
 	)
 
 	if err != nil {
@@ -177,6 +181,13 @@ func (s *UserRepo) GetList(ctx context.Context, req *pb.GetUserListReq) (res *pb
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"created_at": "created_at",
+		"first_name": "first_name",
+		"last_name":  "last_name",
+		"email":      "email",
+	}
+
 	query := `SELECT
 		id,                 
     	coalesce(username, ''),           
@@ -187,15 +198,22 @@ func (s *UserRepo) GetList(ctx context.Context, req *pb.GetUserListReq) (res *pb
     	coalesce(country_id::VARCHAR, ''),         
     	coalesce(city_id::VARCHAR, ''),
     	coalesce(gender::VARCHAR, ''),
-    	coalesce(university_id::VARCHAR, '')
+    	coalesce(university_id::VARCHAR, ''),
+    	TO_CHAR(created_at, ` + config.DatabaseCursorTimeLayout + `) AS cursor
 	FROM
 		"user"`
 
 	filter := " WHERE 1=1"
 
+	keysetOrder := ` ORDER BY created_at DESC, id DESC`
+	order, err := util.OrderBy(req.GetSort(), sortColumns, keysetOrder)
+	if err != nil {
+		return res, err
+	}
+
 	offset := " OFFSET 0"
 
-	limit := " LIMIT 10"
+	pageSize := int32(10)
 
 	if len(req.Search) > 0 {
 		params["search"] = req.Search
@@ -211,22 +229,49 @@ func (s *UserRepo) GetList(ctx context.Context, req *pb.GetUserListReq) (res *pb
 	}
 
 	if req.Limit > 0 {
-		params["limit"] = req.Limit
-		limit = " LIMIT :limit"
+		pageSize = req.Limit
 	}
 
-	cQ := `SELECT count(1) FROM "user"` + filter
-
-	cQ, arr = helper.ReplaceQueryParams(cQ, params)
-
-	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
-		&res.Count,
-	)
+	createdFilter, err := util.CreatedRange("created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
 	if err != nil {
 		return res, err
 	}
+	filter += createdFilter
+
+	// one more row is fetched to know whether the next page exists
+	params["limit"] = pageSize + 1
+	limit := " LIMIT :limit"
+
+	if len(req.GetCursor()) > 0 {
+		// the cursor pages the keyset order only, a page of another sort would skip or repeat rows
+		if req.GetSort() != "" {
+			return nil, fmt.Errorf("%w: cursor can't be used with sort", helper.ErrInvalidCursor)
+		}
+
+		createdAt, id, err := helper.DecodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+
+		params["cursor_created_at"] = createdAt
+		params["cursor_id"] = id
+		filter += ` AND (created_at, id) < (:cursor_created_at::TIMESTAMP, :cursor_id::UUID)`
+		order = keysetOrder
+		offset = ""
+	} else {
+		cQ := `SELECT count(1) FROM "user"` + filter
+
+		cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+		err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+			&res.Count,
+		)
+		if err != nil {
+			return res, err
+		}
+	}
 
-	q := query + filter + offset + limit
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
@@ -237,8 +282,11 @@ func (s *UserRepo) GetList(ctx context.Context, req *pb.GetUserListReq) (res *pb
 	}
 	defer rows.Close()
 
+	cursors := []string{}
+
 	for rows.Next() {
 		obj := &pb.User{}
+		var cursor string
 
 		err = rows.Scan(
 			&obj.Id,
@@ -251,12 +299,21 @@ func (s *UserRepo) GetList(ctx context.Context, req *pb.GetUserListReq) (res *pb
 			&obj.CityId,
 			&obj.Gender,
 			&obj.UniversityId,
+			&cursor,
 		)
 		if err != nil {
 			return res, err
 		}
 
 		res.Users = append(res.Users, obj)
+		cursors = append(cursors, cursor)
+	}
+
+	if int32(len(res.Users)) > pageSize {
+		res.Users = res.Users[:pageSize]
+		if order == keysetOrder {
+			res.NextCursor = helper.EncodeCursor(cursors[pageSize-1], res.Users[pageSize-1].Id)
+		}
 	}
 
 	return res, nil
@@ -266,10 +323,18 @@ func (s *UserRepo) GetListWithRole(ctx context.Context, req *pb.GetUserListByRol
 	res = &pb.GetUserListByRoleRes{}
 
 	m := make(map[string]*pb.User)
+	ids := []string{}
 
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"created_at": "u.created_at",
+		"first_name": "u.first_name",
+		"last_name":  "u.last_name",
+		"email":      "u.email",
+	}
+
 	count := `SELECT
 		count(*) over()
 	FROM
@@ -340,6 +405,17 @@ func (s *UserRepo) GetListWithRole(ctx context.Context, req *pb.GetUserListByRol
 		filter += " AND journal_id = :journal_id"
 	}
 
+	createdFilter, err := util.CreatedRange("u.created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
 	cQ := count + filter + groupBy
 
 	cQ, arr = helper.ReplaceQueryParams(cQ, params)
@@ -353,7 +429,7 @@ func (s *UserRepo) GetListWithRole(ctx context.Context, req *pb.GetUserListByRol
 		return nil, err
 	}
 
-	uQ := fmt.Sprintf("WITH user_filtered_list AS (%s%s%s%s%s) %s", with, filter, groupBy, offset, limit, query)
+	uQ := fmt.Sprintf("WITH user_filtered_list AS (%s%s%s%s%s%s) %s%s", with, filter, groupBy, order, offset, limit, query, order)
 
 	uQ, arr = helper.ReplaceQueryParams(uQ, params)
 
@@ -394,11 +470,12 @@ func (s *UserRepo) GetListWithRole(ctx context.Context, req *pb.GetUserListByRol
 		} else {
 			user.Role = append(user.Role, role)
 			m[user.GetId()] = user
+			ids = append(ids, user.GetId())
 		}
 	}
 
-	for _, v := range m {
-		res.Users = append(res.Users, v)
+	for _, id := range ids {
+		res.Users = append(res.Users, m[id])
 	}
 
 	return res, nil
@@ -456,6 +533,11 @@ func (s *UserRepo) Update(ctx context.Context, req *pb.User) (rowsAffected int64
 		params["university_id"] = req.GetUniversityId()
 	}
 
+	if util.IsValidLanguage(req.GetPreferredLanguage()) {
+		fieldVal = append(fieldVal, ` preferred_language = :preferred_language`)
+		params["preferred_language"] = req.GetPreferredLanguage()
+	}
+
 	if req.GetProfSphere() != "" {
 		fieldVal = append(fieldVal, ` prof_sphere = :prof_sphere`)
 		params["prof_sphere"] = req.GetProfSphere()
@@ -534,7 +616,8 @@ func (s *UserRepo) GetByEmail(ctx context.Context, req *pb.GetUserReq) (res *pb.
     	coalesce(degree, ''),             
     	coalesce(address, ''),            
     	coalesce(post_code, ''),
-    	coalesce(university_id::VARCHAR, '')
+    	coalesce(university_id::VARCHAR, ''),
+    	coalesce(preferred_language::VARCHAR, '')
 	FROM
 		"user"
 	WHERE
@@ -560,6 +643,7 @@ func (s *UserRepo) GetByEmail(ctx context.Context, req *pb.GetUserReq) (res *pb.
 		&res.Address,
 		&res.PostCode,
 		&res.UniversityId,
+		&res.PreferredLanguage,
 	)
 
 	if err != nil {
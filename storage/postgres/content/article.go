@@ -7,17 +7,16 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"fmt"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
-	"time"
 )
 
 type ArticleRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewArticleRepo(db *pgxpool.Pool) storage.ContentArticleRepoI {
+func NewArticleRepo(db models.DB) storage.ContentArticleRepoI {
 	return &ArticleRepo{
 		db: db,
 	}
@@ -129,6 +128,12 @@ func (s *ArticleRepo) Get(ctx context.Context, req *pb.GetArticleReq) (res *pb.G
 		return res, err
 	}
 
+	keywords, err := s.getKeywords(ctx, []string{res.Id})
+	if err != nil {
+		return res, err
+	}
+	res.Keywords = keywords[res.Id]
+
 	return res, nil
 }
 
@@ -137,12 +142,12 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 	params := make(map[string]interface{})
 	var arr []interface{}
 
-	validSortColumns := map[string]bool{
-		"id":         true,
-		"title":      true,
-		"created_at": true,
-		"author":     true,
-		"edition":    true,
+	sortColumns := util.SortColumns{
+		"id":         "id",
+		"title":      "title",
+		"created_at": "created_at",
+		"author":     "author",
+		"edition":    "edition",
 	}
 
 	query := `SELECT
@@ -155,17 +160,22 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
         author,
         content,
         TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at, 
-	    TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+	    TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
+	    TO_CHAR(created_at, ` + config.DatabaseCursorTimeLayout + `) AS cursor
 	FROM
 		"article"`
 
 	filter := " WHERE 1=1"
 
-	orderBy := ""
+	keysetOrder := ` ORDER BY created_at DESC, id DESC`
+	orderBy, err := util.OrderBy(req.GetSort(), sortColumns, keysetOrder)
+	if err != nil {
+		return res, err
+	}
 
 	offset := " OFFSET 0"
 
-	limit := " LIMIT 10"
+	pageSize := int32(10)
 
 	if util.IsValidUUID(req.JournalId) {
 		params["journal_id"] = req.JournalId
@@ -174,23 +184,27 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 
 	if len(req.Search) > 0 {
 		params["search"] = req.Search
-		filter += ` AND (title ILIKE '%' || :search || '%')`
+		filter += ` AND ((search_vector @@ multilingual_tsquery(:search))
+					OR (title ILIKE '%' || :search || '%'))`
 	}
 
-	if _, err := time.Parse(time.RFC3339, req.GetDateFrom()); err == nil {
-		params["date_from"] = req.DateFrom
-		filter += ` AND created_at >= :date_from`
+	if util.IsValidUUID(req.GetKeywordId()) {
+		params["keyword_id"] = req.GetKeywordId()
+		filter += ` AND EXISTS (SELECT 1 FROM "article_keyword" ak WHERE ak.article_id = "article".id AND ak.keyword_id = :keyword_id)`
 	}
 
-	if _, err := time.Parse(time.RFC3339, req.GetDateTo()); err == nil {
-		params["date_to"] = req.DateTo
-		filter += ` AND created_at < :date_to`
+	createdFrom, createdTo := req.GetCreatedFrom(), req.GetCreatedTo()
+	if createdFrom == "" {
+		createdFrom = req.GetDateFrom()
 	}
-
-	if _, ok := validSortColumns[req.GetSort()]; ok {
-		params["sort"] = req.Sort
-		orderBy += ` ORDER BY :sort`
+	if createdTo == "" {
+		createdTo = req.GetDateTo()
 	}
+	createdFilter, err := util.CreatedRange("created_at", createdFrom, createdTo, params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
 
 	if req.Offset > 0 {
 		params["offset"] = req.Offset
@@ -198,19 +212,39 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 	}
 
 	if req.Limit > 0 {
-		params["limit"] = req.Limit
-		limit = " LIMIT :limit"
+		pageSize = req.Limit
 	}
 
-	cQ := `SELECT count(1) FROM "article"` + filter
+	// one more row is fetched to know whether the next page exists
+	params["limit"] = pageSize + 1
+	limit := " LIMIT :limit"
 
-	cQ, arr = helper.ReplaceQueryParams(cQ, params)
-	fmt.Println(cQ)
-	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
-		&res.Count,
-	)
-	if err != nil {
-		return res, err
+	if len(req.GetCursor()) > 0 {
+		// the cursor pages the keyset order only, a page of another sort would skip or repeat rows
+		if req.GetSort() != "" {
+			return nil, fmt.Errorf("%w: cursor can't be used with sort", helper.ErrInvalidCursor)
+		}
+
+		createdAt, id, err := helper.DecodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+
+		params["cursor_created_at"] = createdAt
+		params["cursor_id"] = id
+		filter += ` AND (created_at, id) < (:cursor_created_at::TIMESTAMP, :cursor_id::UUID)`
+		orderBy = keysetOrder
+		offset = ""
+	} else {
+		cQ := `SELECT count(1) FROM "article"` + filter
+
+		cQ, arr = helper.ReplaceQueryParams(cQ, params)
+		err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+			&res.Count,
+		)
+		if err != nil {
+			return res, err
+		}
 	}
 
 	q := query + filter + orderBy + offset + limit
@@ -222,8 +256,12 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 	}
 	defer rows.Close()
 
+	ids := []string{}
+	cursors := []string{}
+
 	for rows.Next() {
 		obj := &pb.Article{}
+		var cursor string
 
 		err = rows.Scan(
 			&obj.Id,
@@ -236,12 +274,32 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 			&obj.Content,
 			&obj.CreatedAt,
 			&obj.UpdatedAt,
+			&cursor,
 		)
 		if err != nil {
 			return res, err
 		}
 
 		res.Articles = append(res.Articles, obj)
+		ids = append(ids, obj.Id)
+		cursors = append(cursors, cursor)
+	}
+	rows.Close()
+
+	if int32(len(res.Articles)) > pageSize {
+		res.Articles = res.Articles[:pageSize]
+		if orderBy == keysetOrder {
+			res.NextCursor = helper.EncodeCursor(cursors[pageSize-1], res.Articles[pageSize-1].Id)
+		}
+	}
+
+	keywords, err := s.getKeywords(ctx, ids)
+	if err != nil {
+		return res, err
+	}
+
+	for _, val := range res.Articles {
+		val.Keywords = keywords[val.Id]
 	}
 
 	return res, nil
@@ -312,3 +370,68 @@ func (s *ArticleRepo) Delete(ctx context.Context, req *pb.DeleteArticleReq) (row
 
 	return rowsAffected, err
 }
+
+// SetKeywords replaces the article keywords
+func (s *ArticleRepo) SetKeywords(ctx context.Context, articleId string, keywordIds []string) error {
+	_, err := s.db.Exec(ctx, `DELETE FROM "article_keyword" WHERE article_id = $1`, articleId)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO "article_keyword" (
+		article_id,
+		keyword_id
+	) VALUES (
+		$1,
+		$2
+	) ON CONFLICT DO NOTHING`
+
+	for _, val := range keywordIds {
+		_, err = s.db.Exec(ctx, query, articleId, val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getKeywords returns the keywords of the articles by article id
+func (s *ArticleRepo) getKeywords(ctx context.Context, articleIds []string) (map[string][]*pb.Keyword, error) {
+	res := make(map[string][]*pb.Keyword)
+
+	query := `SELECT
+		ak.article_id,
+		k.id,
+		k.word
+	FROM
+		"article_keyword" ak
+	INNER JOIN "keyword" k ON k.id = ak.keyword_id
+	WHERE
+		ak.article_id::VARCHAR = ANY($1)
+	ORDER BY k.word`
+
+	rows, err := s.db.Query(ctx, query, articleIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleId string
+		obj := &pb.Keyword{}
+
+		err = rows.Scan(
+			&articleId,
+			&obj.Id,
+			&obj.Title,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res[articleId] = append(res[articleId], obj)
+	}
+
+	return res, nil
+}
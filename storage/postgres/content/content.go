@@ -2,12 +2,11 @@ package content
 
 import (
 	"editory_submission/storage"
-
-	"github.com/jackc/pgx/v4/pgxpool"
+	"editory_submission/storage/postgres/models"
 )
 
 type contentRepo struct {
-	db             *pgxpool.Pool
+	db             models.DB
 	journal        storage.JournalRepoI
 	article        storage.ContentArticleRepoI
 	edition        storage.EditionRepoI
@@ -15,9 +14,11 @@ type contentRepo struct {
 	university     storage.UniversityRepoI
 	subject        storage.SubjectRepoI
 	journalAuthor  storage.JournalAuthorRepoI
+	reviewForm     storage.ReviewFormRepoI
+	search         storage.SearchRepoI
 }
 
-func NewContentRepo(db *pgxpool.Pool) storage.ContentRepoI {
+func NewContentRepo(db models.DB) storage.ContentRepoI {
 	return &contentRepo{
 		db: db,
 	}
@@ -78,3 +79,19 @@ func (s *contentRepo) JournalAuthor() storage.JournalAuthorRepoI {
 
 	return s.journalAuthor
 }
+
+func (s *contentRepo) ReviewForm() storage.ReviewFormRepoI {
+	if s.reviewForm == nil {
+		s.reviewForm = NewReviewFormRepo(s.db)
+	}
+
+	return s.reviewForm
+}
+
+func (s *contentRepo) Search() storage.SearchRepoI {
+	if s.search == nil {
+		s.search = NewSearchRepo(s.db)
+	}
+
+	return s.search
+}
@@ -6,14 +6,14 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
-	"github.com/jackc/pgx/v4/pgxpool"
+	"editory_submission/storage/postgres/models"
 )
 
 type CountryAndCityRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewCountryAndCityRepo(db *pgxpool.Pool) storage.CountryAndCityRepoI {
+func NewCountryAndCityRepo(db models.DB) storage.CountryAndCityRepoI {
 	return &CountryAndCityRepo{
 		db: db,
 	}
@@ -5,16 +5,17 @@ import (
 	"editory_submission/config"
 	pb "editory_submission/genproto/content_service"
 	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type EditionRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewEditionRepo(db *pgxpool.Pool) storage.EditionRepoI {
+func NewEditionRepo(db models.DB) storage.EditionRepoI {
 	return &EditionRepo{
 		db: db,
 	}
@@ -110,6 +111,12 @@ func (s *EditionRepo) GetList(ctx context.Context, req *pb.GetEditionListReq) (r
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"edition":    "edition",
+		"title":      "title",
+		"created_at": "created_at",
+	}
+
 	query := `SELECT
 		id,                 
     	coalesce(journal_id::VARCHAR, '') as journal_id,           
@@ -128,7 +135,8 @@ func (s *EditionRepo) GetList(ctx context.Context, req *pb.GetEditionListReq) (r
 
 	if len(req.Search) > 0 {
 		params["search"] = req.Search
-		filter += ` AND (file ILIKE '%' || :search || '%')`
+		filter += ` AND ((title ILIKE '%' || :search || '%')
+					OR (description ILIKE '%' || :search || '%'))`
 	}
 
 	if req.Offset > 0 {
@@ -141,6 +149,12 @@ func (s *EditionRepo) GetList(ctx context.Context, req *pb.GetEditionListReq) (r
 		limit = " LIMIT :limit"
 	}
 
+	createdFilter, err := util.CreatedRange("created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
 	cQ := `SELECT count(1) FROM "edition"` + filter
 
 	cQ, arr = helper.ReplaceQueryParams(cQ, params)
@@ -152,7 +166,12 @@ func (s *EditionRepo) GetList(ctx context.Context, req *pb.GetEditionListReq) (r
 		return res, err
 	}
 
-	q := query + filter + offset + limit
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
@@ -12,15 +12,13 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
-	"github.com/jackc/pgx/v4/pgxpool"
-	"time"
 )
 
 type JournalRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewJournalRepo(db *pgxpool.Pool) storage.JournalRepoI {
+func NewJournalRepo(db models.DB) storage.JournalRepoI {
 	return &JournalRepo{
 		db: db,
 	}
@@ -39,7 +37,9 @@ func (s *JournalRepo) Create(ctx context.Context, req *pb.CreateJournalReq) (res
     	price,        
     	isbn,              
     	author_id,
-    	status
+    	status,
+    	review_due_days,
+    	review_mode
 	) VALUES (
 		$1,
 		$2,
@@ -49,7 +49,9 @@ func (s *JournalRepo) Create(ctx context.Context, req *pb.CreateJournalReq) (res
 		$6,
 		$7,
 		$8,
-		$9
+		$9,
+		$10,
+		$11
 	) RETURNING 
 	    id, 
 	    cover_photo, 
@@ -60,6 +62,8 @@ func (s *JournalRepo) Create(ctx context.Context, req *pb.CreateJournalReq) (res
 	    isbn, 
 	    author_id,
 	    status,
+	    review_due_days,
+	    review_mode,
 	    TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at, 
 	    TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at`
 
@@ -68,6 +72,16 @@ func (s *JournalRepo) Create(ctx context.Context, req *pb.CreateJournalReq) (res
 		return nil, err
 	}
 
+	reviewDueDays := req.GetReviewDueDays()
+	if reviewDueDays <= 0 {
+		reviewDueDays = config.DefaultReviewDueDays
+	}
+
+	reviewMode := req.GetReviewMode()
+	if reviewMode == "" {
+		reviewMode = config.REVIEW_MODE_OPEN
+	}
+
 	err = s.db.QueryRow(ctx, query,
 		id.String(),
 		req.GetCoverPhoto(),
@@ -78,6 +92,8 @@ func (s *JournalRepo) Create(ctx context.Context, req *pb.CreateJournalReq) (res
 		req.GetIsbn(),
 		req.GetAuthorId(),
 		req.GetStatus(),
+		reviewDueDays,
+		reviewMode,
 	).Scan(
 		&res.Id,
 		&res.CoverPhoto,
@@ -88,6 +104,8 @@ func (s *JournalRepo) Create(ctx context.Context, req *pb.CreateJournalReq) (res
 		&res.Isbn,
 		&res.AuthorId,
 		&res.Status,
+		&res.ReviewDueDays,
+		&res.ReviewMode,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 	)
@@ -118,6 +136,8 @@ func (s *JournalRepo) Get(ctx context.Context, req *pb.PrimaryKey) (res *pb.Jour
 		coalesce(j.citation_indicator, ''),
 		coalesce(j.impact_factor, ''),
 		coalesce(j.short_description, ''),
+		j.review_due_days,
+		j.review_mode,
     	TO_CHAR(j.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at, 
 	    TO_CHAR(j.updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
 		coalesce(u.id::VARCHAR, ''),
@@ -149,6 +169,8 @@ func (s *JournalRepo) Get(ctx context.Context, req *pb.PrimaryKey) (res *pb.Jour
 		&res.CitationIndicator,
 		&res.ImpactFactor,
 		&res.ShortDescription,
+		&res.ReviewDueDays,
+		&res.ReviewMode,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		&author.Id,
@@ -170,13 +192,14 @@ func (s *JournalRepo) GetList(ctx context.Context, req *pb.GetList) (res *pb.Get
 	res = &pb.GetJournalListRes{}
 	params := make(map[string]interface{})
 	var arr []interface{}
-	validSortColumns := map[string]bool{
-		"id":         true,
-		"title":      true,
-		"price":      true,
-		"isbn":       true,
-		"status":     true,
-		"created_at": true,
+
+	sortColumns := util.SortColumns{
+		"id":         "j.id",
+		"title":      "j.title",
+		"price":      "j.price",
+		"isbn":       "j.isbn",
+		"status":     "j.status",
+		"created_at": "j.created_at",
 	}
 
 	query := `SELECT
@@ -195,6 +218,8 @@ func (s *JournalRepo) GetList(ctx context.Context, req *pb.GetList) (res *pb.Get
 		coalesce(j.citation_indicator, ''),
 		coalesce(j.impact_factor, ''),
 		coalesce(j.short_description, ''),
+		j.review_due_days,
+		j.review_mode,
     	TO_CHAR(j.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at, 
 	    TO_CHAR(j.updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
 		coalesce(u.id::VARCHAR, ''),
@@ -211,34 +236,35 @@ func (s *JournalRepo) GetList(ctx context.Context, req *pb.GetList) (res *pb.Get
 
 	limit := " LIMIT 10"
 
-	orderBy := ""
+	orderBy, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
 
 	if len(req.Search) > 0 {
 		params["search"] = req.Search
-		filter += ` AND ((title ILIKE '%' || :search || '%')
-					OR (description ILIKE '%' || :search || '%')
+		filter += ` AND ((search_vector @@ multilingual_tsquery(:search))
+					OR (title ILIKE '%' || :search || '%')
 					OR (isbn ILIKE '%' || :search || '%'))`
 	}
 
 	if req.GetStatus() != "" {
 		params["status"] = req.Status
-		filter += ` AND status = :status`
+		filter += ` AND j.status = :status`
 	}
 
-	if _, err := time.Parse(time.RFC3339, req.GetDateFrom()); err == nil {
-		params["date_from"] = req.DateFrom
-		filter += ` AND created_at >= :date_from`
+	createdFrom, createdTo := req.GetCreatedFrom(), req.GetCreatedTo()
+	if createdFrom == "" {
+		createdFrom = req.GetDateFrom()
 	}
-
-	if _, err := time.Parse(time.RFC3339, req.GetDateTo()); err == nil {
-		params["date_to"] = req.DateTo
-		filter += ` AND created_at < :date_to`
+	if createdTo == "" {
+		createdTo = req.GetDateTo()
 	}
-
-	if _, ok := validSortColumns[req.GetSort()]; ok {
-		params["sort"] = req.Sort
-		orderBy += ` ORDER BY :sort`
+	createdFilter, err := util.CreatedRange("j.created_at", createdFrom, createdTo, params)
+	if err != nil {
+		return res, err
 	}
+	filter += createdFilter
 
 	if req.Offset > 0 {
 		params["offset"] = req.Offset
@@ -250,7 +276,7 @@ func (s *JournalRepo) GetList(ctx context.Context, req *pb.GetList) (res *pb.Get
 		limit = " LIMIT :limit"
 	}
 
-	cQ := `SELECT count(1) FROM "journal"` + filter
+	cQ := `SELECT count(1) FROM "journal" j` + filter
 
 	cQ, arr = helper.ReplaceQueryParams(cQ, params)
 
@@ -292,6 +318,8 @@ func (s *JournalRepo) GetList(ctx context.Context, req *pb.GetList) (res *pb.Get
 			&obj.CitationIndicator,
 			&obj.ImpactFactor,
 			&obj.ShortDescription,
+			&obj.ReviewDueDays,
+			&obj.ReviewMode,
 			&obj.CreatedAt,
 			&obj.UpdatedAt,
 			&author.Id,
@@ -354,16 +382,7 @@ func (s *JournalRepo) Update(ctx context.Context, req *pb.Journal) (res *pb.Jour
 		params["status"] = req.Status
 	}
 
-	if req.AcceptanceRate != "" {
-		querySet += `, acceptance_rate = :acceptance_rate`
-		params["acceptance_rate"] = req.AcceptanceRate
-	}
-
-	if req.SubmissionToFinalDecision != "" {
-		querySet += `, submission_to_final_decision = :submission_to_final_decision`
-		params["submission_to_final_decision"] = req.SubmissionToFinalDecision
-	}
-
+	// acceptance_rate and submission_to_final_decision are computed from the submissions by UpdateMetrics
 	if req.AcceptanceToPublication != "" {
 		querySet += `, acceptance_to_publication = :acceptance_to_publication`
 		params["acceptance_to_publication"] = req.AcceptanceToPublication
@@ -379,6 +398,22 @@ func (s *JournalRepo) Update(ctx context.Context, req *pb.Journal) (res *pb.Jour
 		params["impact_factor"] = req.ImpactFactor
 	}
 
+	if req.ReviewDueDays > 0 {
+		querySet += `, review_due_days = :review_due_days`
+		params["review_due_days"] = req.ReviewDueDays
+	}
+
+	validReviewMode := map[string]bool{
+		config.REVIEW_MODE_OPEN:         true,
+		config.REVIEW_MODE_SINGLE_BLIND: true,
+		config.REVIEW_MODE_DOUBLE_BLIND: true,
+	}
+
+	if _, ok := validReviewMode[req.ReviewMode]; ok {
+		querySet += `, review_mode = :review_mode`
+		params["review_mode"] = req.ReviewMode
+	}
+
 	if util.IsValidUUID(req.AuthorId) {
 		querySet += `, author_id = :author_id`
 		params["author_id"] = req.AuthorId
@@ -394,6 +429,25 @@ func (s *JournalRepo) Update(ctx context.Context, req *pb.Journal) (res *pb.Jour
 
 	return req, nil
 }
+
+// UpdateMetrics sets the acceptance rate and the decision time computed from the submissions
+func (s *JournalRepo) UpdateMetrics(ctx context.Context, id, acceptanceRate, submissionToFinalDecision string) (rowsAffected int64, err error) {
+	query := `UPDATE "journal" SET
+		acceptance_rate = $2,
+		submission_to_final_decision = $3,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE id = $1`
+
+	result, err := s.db.Exec(ctx, query, id, acceptanceRate, submissionToFinalDecision)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected = result.RowsAffected()
+
+	return rowsAffected, nil
+}
+
 func (s *JournalRepo) Delete(ctx context.Context, req *pb.PrimaryKey) (rowsAffected int64, err error) {
 	query := `DELETE FROM "journal" WHERE id = $1`
 
@@ -7,17 +7,17 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"errors"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type JournalAuthorRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewJournalAuthorRepo(db *pgxpool.Pool) storage.JournalAuthorRepoI {
+func NewJournalAuthorRepo(db models.DB) storage.JournalAuthorRepoI {
 	return &JournalAuthorRepo{
 		db: db,
 	}
@@ -147,6 +147,12 @@ func (s *JournalAuthorRepo) GetList(ctx context.Context, req *pb.GetJournalAutho
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"full_name":  "a.full_name",
+		"email":      "a.email",
+		"created_at": "a.created_at",
+	}
+
 	query := `SELECT
 		a.id,                            
     	journal_id,
@@ -194,7 +200,13 @@ func (s *JournalAuthorRepo) GetList(ctx context.Context, req *pb.GetJournalAutho
 		limit = " LIMIT :limit"
 	}
 
-	cQ := `SELECT count(1) FROM "journal_author"` + filter
+	createdFilter, err := util.CreatedRange("a.created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
+	cQ := `SELECT count(1) FROM "journal_author" a` + filter
 
 	cQ, arr = helper.ReplaceQueryParams(cQ, params)
 
@@ -207,7 +219,12 @@ func (s *JournalAuthorRepo) GetList(ctx context.Context, req *pb.GetJournalAutho
 		return nil, err
 	}
 
-	q := query + filter + offset + limit
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
@@ -0,0 +1,420 @@
+package content
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/content_service"
+	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+type ReviewFormRepo struct {
+	db models.DB
+}
+
+func NewReviewFormRepo(db models.DB) storage.ReviewFormRepoI {
+	return &ReviewFormRepo{
+		db: db,
+	}
+}
+
+func (s *ReviewFormRepo) Create(ctx context.Context, req *pb.CreateReviewFormReq) (res *pb.ReviewForm, err error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// journal has only one active form
+	if req.GetActive() {
+		_, err = tx.Exec(ctx, `UPDATE "review_form" SET active = false, updated_at = CURRENT_TIMESTAMP WHERE journal_id = $1 AND active`, req.GetJournalId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := `INSERT INTO "review_form" (
+		id,
+		journal_id,
+		title,
+		description,
+		active
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5
+	)`
+
+	_, err = tx.Exec(ctx, query,
+		id.String(),
+		req.GetJournalId(),
+		req.GetTitle(),
+		req.GetDescription(),
+		req.GetActive(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.upsertQuestions(ctx, tx, id.String(), req.GetQuestions())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, &pb.GetReviewFormReq{
+		Id: id.String(),
+	})
+}
+
+func (s *ReviewFormRepo) Get(ctx context.Context, req *pb.GetReviewFormReq) (res *pb.ReviewForm, err error) {
+	res = &pb.ReviewForm{}
+	params := make(map[string]interface{})
+
+	query := `SELECT
+		id,
+		journal_id,
+		title,
+		COALESCE(description, ''),
+		active,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+	FROM
+		"review_form"`
+	filter := " WHERE 1=1"
+
+	if util.IsValidUUID(req.GetId()) {
+		params["id"] = req.GetId()
+		filter += ` AND id = :id`
+	} else {
+		filter += ` AND active`
+	}
+
+	if util.IsValidUUID(req.GetJournalId()) {
+		params["journal_id"] = req.GetJournalId()
+		filter += ` AND journal_id = :journal_id`
+	}
+
+	q, arr := helper.ReplaceQueryParams(query+filter, params)
+	err = s.db.QueryRow(ctx, q, arr...).Scan(
+		&res.Id,
+		&res.JournalId,
+		&res.Title,
+		&res.Description,
+		&res.Active,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	queryQuestion := `SELECT
+		id,
+		review_form_id,
+		type,
+		text,
+		required,
+		scale_min,
+		scale_max,
+		position
+	FROM
+		"review_form_question"
+	WHERE
+		review_form_id = $1
+	ORDER BY position, created_at`
+
+	rows, err := s.db.Query(ctx, queryQuestion, res.Id)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.ReviewFormQuestion{}
+
+		err = rows.Scan(
+			&obj.Id,
+			&obj.ReviewFormId,
+			&obj.Type,
+			&obj.Text,
+			&obj.Required,
+			&obj.ScaleMin,
+			&obj.ScaleMax,
+			&obj.Position,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		res.Questions = append(res.Questions, obj)
+	}
+
+	return res, nil
+}
+
+func (s *ReviewFormRepo) GetList(ctx context.Context, req *pb.GetReviewFormListReq) (res *pb.GetReviewFormListRes, err error) {
+	res = &pb.GetReviewFormListRes{}
+	params := make(map[string]interface{})
+	var arr []interface{}
+
+	sortColumns := util.SortColumns{
+		"title":      "title",
+		"active":     "active",
+		"created_at": "created_at",
+	}
+
+	query := `SELECT
+		id,
+		journal_id,
+		title,
+		COALESCE(description, ''),
+		active,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+	FROM
+		"review_form"`
+	filter := " WHERE 1=1"
+
+	order, err := util.OrderBy(req.GetSort(), sortColumns, " ORDER BY active DESC, created_at DESC")
+	if err != nil {
+		return res, err
+	}
+
+	offset := " OFFSET 0"
+
+	limit := " LIMIT 10"
+
+	if len(req.Search) > 0 {
+		params["search"] = req.Search
+		filter += ` AND (title ILIKE '%' || :search || '%')`
+	}
+
+	if util.IsValidUUID(req.GetJournalId()) {
+		params["journal_id"] = req.GetJournalId()
+		filter += ` AND journal_id = :journal_id`
+	}
+
+	if req.Offset > 0 {
+		params["offset"] = req.Offset
+		offset = " OFFSET :offset"
+	}
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+		limit = " LIMIT :limit"
+	}
+
+	createdFilter, err := util.CreatedRange("created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
+	cQ := `SELECT count(1) FROM "review_form"` + filter
+
+	cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+		&res.Count,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
+
+	q, arr = helper.ReplaceQueryParams(q, params)
+	rows, err := s.db.Query(ctx, q, arr...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.ReviewForm{}
+
+		err = rows.Scan(
+			&obj.Id,
+			&obj.JournalId,
+			&obj.Title,
+			&obj.Description,
+			&obj.Active,
+			&obj.CreatedAt,
+			&obj.UpdatedAt,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		res.ReviewForms = append(res.ReviewForms, obj)
+	}
+
+	return res, nil
+}
+
+func (s *ReviewFormRepo) Update(ctx context.Context, req *pb.UpdateReviewFormReq) (res *pb.ReviewForm, err error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if req.GetActive() {
+		_, err = tx.Exec(ctx, `UPDATE "review_form" SET active = false, updated_at = CURRENT_TIMESTAMP WHERE journal_id = $1 AND id <> $2 AND active`, req.GetJournalId(), req.GetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := `UPDATE "review_form" SET
+		title = :title,
+		description = :description,
+		active = :active,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE
+		id = :id AND journal_id = :journal_id`
+
+	params := map[string]interface{}{
+		"id":          req.GetId(),
+		"journal_id":  req.GetJournalId(),
+		"title":       req.GetTitle(),
+		"description": req.GetDescription(),
+		"active":      req.GetActive(),
+	}
+
+	q, arr := helper.ReplaceQueryParams(query, params)
+	result, err := tx.Exec(ctx, q, arr...)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.RowsAffected() <= 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	// questions are replaced only when they are sent, answered questions can't be removed
+	if len(req.GetQuestions()) > 0 {
+		ids := []string{}
+		for _, val := range req.GetQuestions() {
+			if util.IsValidUUID(val.GetId()) {
+				ids = append(ids, val.GetId())
+			}
+		}
+
+		_, err = tx.Exec(ctx, `DELETE FROM "review_form_question" WHERE review_form_id = $1 AND NOT (id::VARCHAR = ANY($2))`, req.GetId(), ids)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.upsertQuestions(ctx, tx, req.GetId(), req.GetQuestions())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Get(ctx, &pb.GetReviewFormReq{
+		Id: req.GetId(),
+	})
+}
+
+func (s *ReviewFormRepo) Delete(ctx context.Context, req *pb.DeleteReviewFormReq) (rowsAffected int64, err error) {
+	query := `DELETE FROM "review_form" WHERE id = $1 AND journal_id = $2`
+
+	result, err := s.db.Exec(ctx, query, req.GetId(), req.GetJournalId())
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected = result.RowsAffected()
+
+	return rowsAffected, err
+}
+
+func (s *ReviewFormRepo) upsertQuestions(ctx context.Context, tx pgx.Tx, formId string, questions []*pb.ReviewFormQuestion) error {
+	queryInsert := `INSERT INTO "review_form_question" (
+		id,
+		review_form_id,
+		type,
+		text,
+		required,
+		scale_min,
+		scale_max,
+		position
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7,
+		$8
+	)`
+
+	queryUpdate := `UPDATE "review_form_question" SET
+		type = $3,
+		text = $4,
+		required = $5,
+		scale_min = $6,
+		scale_max = $7,
+		position = $8,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE id = $1 AND review_form_id = $2`
+
+	for i, val := range questions {
+		query := queryUpdate
+		id := val.GetId()
+
+		if !util.IsValidUUID(id) {
+			questionId, err := uuid.NewRandom()
+			if err != nil {
+				return err
+			}
+
+			query = queryInsert
+			id = questionId.String()
+		}
+
+		position := val.GetPosition()
+		if position == 0 {
+			position = int32(i + 1)
+		}
+
+		_, err := tx.Exec(ctx, query,
+			id,
+			formId,
+			val.GetType(),
+			val.GetText(),
+			val.GetRequired(),
+			val.GetScaleMin(),
+			val.GetScaleMax(),
+			position,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package content
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/content_service"
+	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"strings"
+)
+
+// headlineOptions are the ts_headline options of the search snippets
+const headlineOptions = `'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=" ... "'`
+
+// escapeHTML escapes the text of the SQL expression, so the tags of ts_headline are the only HTML of the snippet
+func escapeHTML(expr string) string {
+	return `replace(replace(replace(replace(replace(` + expr + `, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+type SearchRepo struct {
+	db models.DB
+}
+
+func NewSearchRepo(db models.DB) storage.SearchRepoI {
+	return &SearchRepo{
+		db: db,
+	}
+}
+
+// Search ranks articles, journals and drafts by the multilingual tsvector match.
+// Drafts are searched only when they are scoped by the author or the journal
+func (s *SearchRepo) Search(ctx context.Context, req *pb.SearchReq) (res *pb.SearchRes, err error) {
+	res = &pb.SearchRes{}
+	var arr []interface{}
+
+	params := map[string]interface{}{
+		"search": req.GetQ(),
+	}
+
+	articleFilter := ""
+	journalFilter := ""
+	draftFilter := ""
+
+	if util.IsValidUUID(req.GetJournalId()) {
+		params["journal_id"] = req.GetJournalId()
+		articleFilter += ` AND a.journal_id = :journal_id`
+		journalFilter += ` AND j.id = :journal_id`
+		draftFilter += ` AND d.journal_id = :journal_id`
+	}
+
+	if util.IsValidUUID(req.GetAuthorId()) {
+		params["author_id"] = req.GetAuthorId()
+		draftFilter += ` AND d.author_id = :author_id`
+	}
+
+	var found []string
+
+	if req.GetType() == "" || req.GetType() == config.SEARCH_TYPE_ARTICLE {
+		found = append(found, `SELECT
+			a.id,
+			'`+config.SEARCH_TYPE_ARTICLE+`' AS type,
+			COALESCE(a.journal_id::VARCHAR, '') AS journal_id,
+			COALESCE(a.title, '') AS title,
+			COALESCE(a.description, '') || ' ' || COALESCE(a.content, '') AS body,
+			ts_rank(a.search_vector, q.query) AS rank,
+			a.created_at
+		FROM "article" a, q
+		WHERE a.search_vector @@ q.query`+articleFilter)
+	}
+
+	if req.GetType() == "" || req.GetType() == config.SEARCH_TYPE_JOURNAL {
+		found = append(found, `SELECT
+			j.id,
+			'`+config.SEARCH_TYPE_JOURNAL+`' AS type,
+			j.id::VARCHAR AS journal_id,
+			j.title,
+			COALESCE(j.short_description, '') || ' ' || COALESCE(j.description, '') AS body,
+			ts_rank(j.search_vector, q.query) AS rank,
+			j.created_at
+		FROM "journal" j, q
+		WHERE j.search_vector @@ q.query`+journalFilter)
+	}
+
+	if draftFilter != "" && (req.GetType() == "" || req.GetType() == config.SEARCH_TYPE_DRAFT) {
+		found = append(found, `SELECT
+			d.id,
+			'`+config.SEARCH_TYPE_DRAFT+`' AS type,
+			d.journal_id::VARCHAR AS journal_id,
+			COALESCE(d.title, '') AS title,
+			COALESCE(d.description, '') AS body,
+			ts_rank(d.search_vector, q.query) AS rank,
+			d.created_at
+		FROM "draft" d, q
+		WHERE d.search_vector @@ q.query
+			AND NOT EXISTS (SELECT 1 FROM "draft" r WHERE r.group_id = d.group_id AND r.revision > d.revision)`+draftFilter)
+	}
+
+	if len(found) == 0 {
+		return res, nil
+	}
+
+	with := `WITH q AS (
+		SELECT multilingual_tsquery(:search) AS query
+	), found AS (
+		` + strings.Join(found, ` UNION ALL `) + `
+	)`
+
+	query := with + ` SELECT
+		found.id,
+		found.type,
+		found.journal_id,
+		found.title,
+		ts_headline('simple', ` + escapeHTML("found.title") + `, q.query, 'HighlightAll=true, StartSel=<b>, StopSel=</b>'),
+		ts_headline('simple', ` + escapeHTML("found.body") + `, q.query, ` + headlineOptions + `),
+		found.rank::FLOAT,
+		TO_CHAR(found.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at
+	FROM found, q`
+
+	orderBy := ` ORDER BY found.rank DESC, found.created_at DESC`
+
+	offset := " OFFSET 0"
+
+	limit := " LIMIT 10"
+
+	if req.Offset > 0 {
+		params["offset"] = req.Offset
+		offset = " OFFSET :offset"
+	}
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+		limit = " LIMIT :limit"
+	}
+
+	cQ := with + ` SELECT count(1) FROM found`
+
+	cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+		&res.Count,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	q := query + orderBy + offset + limit
+
+	q, arr = helper.ReplaceQueryParams(q, params)
+	rows, err := s.db.Query(ctx, q, arr...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.SearchResult{}
+
+		err = rows.Scan(
+			&obj.Id,
+			&obj.Type,
+			&obj.JournalId,
+			&obj.Title,
+			&obj.TitleHighlight,
+			&obj.Snippet,
+			&obj.Rank,
+			&obj.CreatedAt,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		res.Results = append(res.Results, obj)
+	}
+
+	return res, nil
+}
@@ -4,16 +4,17 @@ import (
 	"context"
 	pb "editory_submission/genproto/content_service"
 	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type SubjectRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewSubjectRepo(db *pgxpool.Pool) storage.SubjectRepoI {
+func NewSubjectRepo(db models.DB) storage.SubjectRepoI {
 	return &SubjectRepo{
 		db: db,
 	}
@@ -83,6 +84,10 @@ func (s *SubjectRepo) GetList(ctx context.Context, req *pb.GetSubjectListReq) (r
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"title": "title",
+	}
+
 	query := `SELECT
 		id,           
     	title
@@ -120,7 +125,12 @@ func (s *SubjectRepo) GetList(ctx context.Context, req *pb.GetSubjectListReq) (r
 		return res, err
 	}
 
-	q := query + filter + offset + limit
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
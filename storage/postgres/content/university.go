@@ -4,16 +4,17 @@ import (
 	"context"
 	pb "editory_submission/genproto/content_service"
 	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type UniversityRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewUniversityRepo(db *pgxpool.Pool) storage.UniversityRepoI {
+func NewUniversityRepo(db models.DB) storage.UniversityRepoI {
 	return &UniversityRepo{
 		db: db,
 	}
@@ -90,6 +91,10 @@ func (s *UniversityRepo) GetList(ctx context.Context, req *pb.GetUniversityListR
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"title": "title",
+	}
+
 	query := `SELECT
 		id,           
     	title,         
@@ -128,7 +133,12 @@ func (s *UniversityRepo) GetList(ctx context.Context, req *pb.GetUniversityListR
 		return res, err
 	}
 
-	q := query + filter + offset + limit
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
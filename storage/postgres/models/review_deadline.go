@@ -0,0 +1,12 @@
+package models
+
+type ReviewDeadline struct {
+	CheckerId     string // draft_checker id
+	DraftId       string
+	JournalId     string
+	ReviewerId    string
+	DraftTitle    string
+	DueAt         string
+	ReviewerName  string
+	ReviewerEmail string
+}
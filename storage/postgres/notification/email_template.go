@@ -5,16 +5,17 @@ import (
 	"editory_submission/config"
 	pb "editory_submission/genproto/notification_service"
 	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type EmailTmpRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewEmailTmpRepo(db *pgxpool.Pool) storage.EmailTemplateRepoI {
+func NewEmailTmpRepo(db models.DB) storage.EmailTemplateRepoI {
 	return &EmailTmpRepo{
 		db: db,
 	}
@@ -29,19 +30,22 @@ func (s *EmailTmpRepo) Create(ctx context.Context, req *pb.CreateEmailTmpReq) (r
     	title,
         description,
         type,
-        text
+        text,
+        language
 	) VALUES (
 		$1,
 		$2,
 		$3,
 		$4,
-		$5
+		$5,
+		$6
 	) RETURNING 
 	    id, 
 	    title,
 	    description,
         type,
         text,
+        language,
         TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at`
 
 	id, err := uuid.NewRandom()
@@ -55,12 +59,14 @@ func (s *EmailTmpRepo) Create(ctx context.Context, req *pb.CreateEmailTmpReq) (r
 		req.GetDescription(),
 		req.GetType(),
 		req.GetText(),
+		req.GetLanguage(),
 	).Scan(
 		&res.Id,
 		&res.Title,
 		&res.Description,
 		&res.Type,
 		&res.Text,
+		&res.Language,
 		&res.CreatedAt,
 	)
 	if err != nil {
@@ -79,6 +85,7 @@ func (s *EmailTmpRepo) Get(ctx context.Context, req *pb.GetEmailTmpReq) (res *pb
 	    description,
         type,
         text,
+        language,
         TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at
 	FROM
 		"email_template"
@@ -94,6 +101,7 @@ func (s *EmailTmpRepo) Get(ctx context.Context, req *pb.GetEmailTmpReq) (res *pb
 		&res.Description,
 		&res.Type,
 		&res.Text,
+		&res.Language,
 		&res.CreatedAt,
 	)
 
@@ -108,12 +116,26 @@ func (s *EmailTmpRepo) GetList(ctx context.Context, req *pb.GetEmailTmpListReq)
 	res = &pb.GetEmailTmpListRes{}
 	params := make(map[string]interface{})
 	var arr []interface{}
+
+	sortColumns := util.SortColumns{
+		"title":      "title",
+		"type":       "type",
+		"language":   "language",
+		"created_at": "created_at",
+	}
+
 	validMailNoType := map[string]bool{
 		config.REGISTRATION:          true,
 		config.NEW_ARTICLE_TO_REVIEW: true,
 		config.RESET_PASSWORD:        true,
 		config.ACCOUNT_DEACTIVATION:  true,
 		config.NEW_JOURNAL_USER:      true,
+		config.REVIEW_REMINDER:       true,
+		config.REVIEW_OVERDUE:        true,
+		config.REVIEW_OVERDUE_EDITOR: true,
+		config.DECISION_ACCEPT:       true,
+		config.DECISION_REJECT:       true,
+		config.DECISION_REVISE:       true,
 	}
 
 	query := `SELECT
@@ -122,6 +144,7 @@ func (s *EmailTmpRepo) GetList(ctx context.Context, req *pb.GetEmailTmpListReq)
 	    description,
         type,
         text,
+        language,
         TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at
 	FROM
 		"email_template"`
@@ -141,6 +164,11 @@ func (s *EmailTmpRepo) GetList(ctx context.Context, req *pb.GetEmailTmpListReq)
 		filter += ` AND type = :type`
 	}
 
+	if util.IsValidLanguage(req.GetLanguage()) {
+		params["language"] = req.GetLanguage()
+		filter += ` AND language = :language`
+	}
+
 	if req.Offset > 0 {
 		params["offset"] = req.Offset
 		offset = " OFFSET :offset"
@@ -151,6 +179,12 @@ func (s *EmailTmpRepo) GetList(ctx context.Context, req *pb.GetEmailTmpListReq)
 		limit = " LIMIT :limit"
 	}
 
+	createdFilter, err := util.CreatedRange("created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
 	cQ := `SELECT count(1) FROM "email_template"` + filter
 
 	cQ, arr = helper.ReplaceQueryParams(cQ, params)
@@ -162,7 +196,12 @@ func (s *EmailTmpRepo) GetList(ctx context.Context, req *pb.GetEmailTmpListReq)
 		return res, err
 	}
 
-	q := query + filter + offset + limit
+	order, err := util.OrderBy(req.GetSort(), sortColumns, "")
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
@@ -180,6 +219,7 @@ func (s *EmailTmpRepo) GetList(ctx context.Context, req *pb.GetEmailTmpListReq)
 			&obj.Description,
 			&obj.Type,
 			&obj.Text,
+			&obj.Language,
 			&obj.CreatedAt,
 		)
 		if err != nil {
@@ -207,6 +247,7 @@ func (s *EmailTmpRepo) Update(ctx context.Context, req *pb.UpdateEmailTmpReq) (r
 		description,
 	    type,
 		text,
+		language,
 		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at`
 
 	params := map[string]interface{}{
@@ -223,6 +264,7 @@ func (s *EmailTmpRepo) Update(ctx context.Context, req *pb.UpdateEmailTmpReq) (r
 		&res.Description,
 		&res.Type,
 		&res.Text,
+		&res.Language,
 		&res.CreatedAt,
 	)
 	if err != nil {
@@ -2,16 +2,17 @@ package notification
 
 import (
 	"editory_submission/storage"
-	"github.com/jackc/pgx/v4/pgxpool"
+	"editory_submission/storage/postgres/models"
 )
 
 type notificationRepo struct {
-	db       *pgxpool.Pool
+	db       models.DB
 	emailTmp storage.EmailTemplateRepoI
 	notify   storage.NotifyRepoI
+	userNtf  storage.UserNotificationRepoI
 }
 
-func NewNotificationRepo(db *pgxpool.Pool) storage.NotificationRepoI {
+func NewNotificationRepo(db models.DB) storage.NotificationRepoI {
 	return &notificationRepo{
 		db: db,
 	}
@@ -32,3 +33,11 @@ func (n notificationRepo) EmailTemplate() storage.EmailTemplateRepoI {
 
 	return n.emailTmp
 }
+
+func (n notificationRepo) UserNotification() storage.UserNotificationRepoI {
+	if n.userNtf == nil {
+		n.userNtf = NewUserNotificationRepo(n.db)
+	}
+
+	return n.userNtf
+}
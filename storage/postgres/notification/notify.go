@@ -5,16 +5,19 @@ import (
 	"editory_submission/config"
 	pb "editory_submission/genproto/notification_service"
 	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"fmt"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
 )
 
 type NotifyRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewNotifyRepo(db *pgxpool.Pool) storage.NotifyRepoI {
+func NewNotifyRepo(db models.DB) storage.NotifyRepoI {
 	return &NotifyRepo{
 		db: db,
 	}
@@ -82,7 +85,10 @@ func (s *NotifyRepo) Get(ctx context.Context, req *pb.GetNotificationReq) (res *
 	    email,
 	    status,
 	    TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
-		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
+		attempts,
+		TO_CHAR(next_attempt_at, ` + config.DatabaseQueryTimeLayout + `) AS next_attempt_at,
+		COALESCE(last_error, '') AS last_error
 	FROM
 		"notification"
 	WHERE
@@ -99,6 +105,9 @@ func (s *NotifyRepo) Get(ctx context.Context, req *pb.GetNotificationReq) (res *
 		&res.Status,
 		&res.CreatedAt,
 		&res.UpdatedAt,
+		&res.Attempts,
+		&res.NextAttemptAt,
+		&res.LastError,
 	)
 
 	if err != nil {
@@ -113,6 +122,12 @@ func (s *NotifyRepo) GetList(ctx context.Context, req *pb.GetNotificationListReq
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"created_at": "created_at",
+		"subject":    "subject",
+		"status":     "status",
+	}
+
 	query := `SELECT
 		id, 
 	    subject,
@@ -120,14 +135,24 @@ func (s *NotifyRepo) GetList(ctx context.Context, req *pb.GetNotificationListReq
 	    email,
 	    status,
 	    TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
-		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
+		attempts,
+		TO_CHAR(next_attempt_at, ` + config.DatabaseQueryTimeLayout + `) AS next_attempt_at,
+		COALESCE(last_error, '') AS last_error,
+		TO_CHAR(created_at, ` + config.DatabaseCursorTimeLayout + `) AS cursor
 	FROM
 		"notification"`
 	filter := " WHERE 1=1"
 
+	keysetOrder := ` ORDER BY created_at DESC, id DESC`
+	order, err := util.OrderBy(req.GetSort(), sortColumns, keysetOrder)
+	if err != nil {
+		return res, err
+	}
+
 	offset := " OFFSET 0"
 
-	limit := " LIMIT 10"
+	pageSize := int32(10)
 
 	if len(req.Search) > 0 {
 		params["search"] = req.Search
@@ -140,22 +165,49 @@ func (s *NotifyRepo) GetList(ctx context.Context, req *pb.GetNotificationListReq
 	}
 
 	if req.Limit > 0 {
-		params["limit"] = req.Limit
-		limit = " LIMIT :limit"
+		pageSize = req.Limit
 	}
 
-	cQ := `SELECT count(1) FROM "notification"` + filter
-
-	cQ, arr = helper.ReplaceQueryParams(cQ, params)
-
-	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
-		&res.Count,
-	)
+	createdFilter, err := util.CreatedRange("created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
 	if err != nil {
 		return res, err
 	}
+	filter += createdFilter
+
+	// one more row is fetched to know whether the next page exists
+	params["limit"] = pageSize + 1
+	limit := " LIMIT :limit"
+
+	if len(req.GetCursor()) > 0 {
+		// the cursor pages the keyset order only, a page of another sort would skip or repeat rows
+		if req.GetSort() != "" {
+			return nil, fmt.Errorf("%w: cursor can't be used with sort", helper.ErrInvalidCursor)
+		}
+
+		createdAt, id, err := helper.DecodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, err
+		}
+
+		params["cursor_created_at"] = createdAt
+		params["cursor_id"] = id
+		filter += ` AND (created_at, id) < (:cursor_created_at::TIMESTAMP, :cursor_id::UUID)`
+		order = keysetOrder
+		offset = ""
+	} else {
+		cQ := `SELECT count(1) FROM "notification"` + filter
+
+		cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+		err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+			&res.Count,
+		)
+		if err != nil {
+			return res, err
+		}
+	}
 
-	q := query + filter + offset + limit
+	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
 	rows, err := s.db.Query(ctx, q, arr...)
@@ -164,8 +216,11 @@ func (s *NotifyRepo) GetList(ctx context.Context, req *pb.GetNotificationListReq
 	}
 	defer rows.Close()
 
+	cursors := []string{}
+
 	for rows.Next() {
 		obj := &pb.Notification{}
+		var cursor string
 
 		err = rows.Scan(
 			&obj.Id,
@@ -175,12 +230,24 @@ func (s *NotifyRepo) GetList(ctx context.Context, req *pb.GetNotificationListReq
 			&obj.Status,
 			&obj.CreatedAt,
 			&obj.UpdatedAt,
+			&obj.Attempts,
+			&obj.NextAttemptAt,
+			&obj.LastError,
+			&cursor,
 		)
 		if err != nil {
 			return res, err
 		}
 
 		res.Notifications = append(res.Notifications, obj)
+		cursors = append(cursors, cursor)
+	}
+
+	if int32(len(res.Notifications)) > pageSize {
+		res.Notifications = res.Notifications[:pageSize]
+		if order == keysetOrder {
+			res.NextCursor = helper.EncodeCursor(cursors[pageSize-1], res.Notifications[pageSize-1].Id)
+		}
 	}
 
 	return res, nil
@@ -235,3 +302,128 @@ func (s *NotifyRepo) Delete(ctx context.Context, req *pb.DeleteNotificationReq)
 
 	return rowsAffected, err
 }
+
+// Claim locks the notifications due to be sent and leases them to the caller as PENDING.
+// A lease which isn't finished in time, e.g. the process was stopped while sending, is claimed again,
+// unless it was the last attempt, then the notification is marked DEAD instead of being sent forever
+func (s *NotifyRepo) Claim(ctx context.Context, limit int, lease time.Duration) (res []*pb.Notification, err error) {
+	query := `WITH dead AS (
+		UPDATE "notification" SET
+			status = '` + config.EMAIL_STATUS_DEAD + `',
+			last_error = 'lease of the last attempt expired',
+			updated_at = now()
+		WHERE status IN ('` + config.EMAIL_STATUS_NEW + `', '` + config.EMAIL_STATUS_PENDING + `', '` + config.EMAIL_STATUS_FAILED + `')
+			AND next_attempt_at <= now()
+			AND attempts >= $3
+	)
+	UPDATE "notification" n SET
+		status = '` + config.EMAIL_STATUS_PENDING + `',
+		attempts = n.attempts + 1,
+		next_attempt_at = now() + make_interval(secs => $2),
+		updated_at = now()
+	FROM (
+		SELECT id
+		FROM "notification"
+		WHERE status IN ('` + config.EMAIL_STATUS_NEW + `', '` + config.EMAIL_STATUS_PENDING + `', '` + config.EMAIL_STATUS_FAILED + `')
+			AND next_attempt_at <= now()
+			AND attempts < $3
+		ORDER BY next_attempt_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	) due
+	WHERE n.id = due.id
+	RETURNING
+		n.id,
+		n.subject,
+		n.text,
+		n.email,
+		n.status,
+		TO_CHAR(n.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(n.updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
+		n.attempts,
+		TO_CHAR(n.next_attempt_at, ` + config.DatabaseQueryTimeLayout + `) AS next_attempt_at,
+		COALESCE(n.last_error, '') AS last_error`
+
+	rows, err := s.db.Query(ctx, query, limit, lease.Seconds(), config.NotificationMaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.Notification{}
+
+		err = rows.Scan(
+			&obj.Id,
+			&obj.Subject,
+			&obj.Text,
+			&obj.Email,
+			&obj.Status,
+			&obj.CreatedAt,
+			&obj.UpdatedAt,
+			&obj.Attempts,
+			&obj.NextAttemptAt,
+			&obj.LastError,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
+
+// Release returns the leased notifications which weren't sent to the outbox, the attempts are not counted
+func (s *NotifyRepo) Release(ctx context.Context, ids []string) error {
+	query := `UPDATE "notification" SET
+		status = '` + config.EMAIL_STATUS_NEW + `',
+		attempts = GREATEST(attempts - 1, 0),
+		next_attempt_at = now(),
+		updated_at = now()
+	WHERE id = ANY($1) AND status = '` + config.EMAIL_STATUS_PENDING + `'`
+
+	_, err := s.db.Exec(ctx, query, ids)
+
+	return err
+}
+
+func (s *NotifyRepo) MarkSent(ctx context.Context, id string) error {
+	query := `UPDATE "notification" SET
+		status = '` + config.EMAIL_STATUS_SENT + `',
+		last_error = NULL,
+		updated_at = now()
+	WHERE id = $1`
+
+	_, err := s.db.Exec(ctx, query, id)
+
+	return err
+}
+
+// Retry marks the failed attempt, the notification is claimed again after the delay
+func (s *NotifyRepo) Retry(ctx context.Context, id, lastError string, delay time.Duration) error {
+	query := `UPDATE "notification" SET
+		status = '` + config.EMAIL_STATUS_FAILED + `',
+		last_error = $2,
+		next_attempt_at = now() + make_interval(secs => $3),
+		updated_at = now()
+	WHERE id = $1`
+
+	_, err := s.db.Exec(ctx, query, id, lastError, delay.Seconds())
+
+	return err
+}
+
+// MarkDead moves the notification out of the outbox after the last failed attempt
+func (s *NotifyRepo) MarkDead(ctx context.Context, id, lastError string) error {
+	query := `UPDATE "notification" SET
+		status = '` + config.EMAIL_STATUS_DEAD + `',
+		last_error = $2,
+		updated_at = now()
+	WHERE id = $1`
+
+	_, err := s.db.Exec(ctx, query, id, lastError)
+
+	return err
+}
@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/notification_service"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+// testTx returns a transaction of the database from POSTGRES_HOST which is rolled back when the test ends,
+// the test is skipped when the database isn't set or available
+func testTx(t *testing.T) (context.Context, pgx.Tx) {
+	if os.Getenv("POSTGRES_HOST") == "" {
+		t.Skip("set POSTGRES_HOST to run the database tests")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	cfg := config.Load()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		cfg.PostgresUser,
+		cfg.PostgresPassword,
+		cfg.PostgresHost,
+		cfg.PostgresPort,
+		cfg.PostgresDatabase,
+	))
+	if err != nil {
+		t.Skipf("postgres is not available: %v", err)
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = tx.Rollback(context.Background())
+		_ = conn.Close(context.Background())
+	})
+
+	return ctx, tx
+}
+
+func TestNotifyRepoClaim(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		attempts int32
+		claimed  bool
+		want     string
+	}{
+		{name: "new notification is leased", status: config.EMAIL_STATUS_NEW, claimed: true, want: config.EMAIL_STATUS_PENDING},
+		{name: "failed notification is leased again", status: config.EMAIL_STATUS_FAILED, attempts: 1, claimed: true, want: config.EMAIL_STATUS_PENDING},
+		{name: "expired lease is leased again", status: config.EMAIL_STATUS_PENDING, attempts: config.NotificationMaxAttempts - 1, claimed: true, want: config.EMAIL_STATUS_PENDING},
+		{name: "expired lease of the last attempt is dead", status: config.EMAIL_STATUS_PENDING, attempts: config.NotificationMaxAttempts, want: config.EMAIL_STATUS_DEAD},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, tx := testTx(t)
+
+			// the notifications queued before the test are claimed too, they are rolled back with the test
+			repo := NewNotifyRepo(tx)
+
+			notification, err := repo.Create(ctx, &pb.CreateNotificationReq{
+				Subject: "Test",
+				Text:    "Test",
+				Email:   "test@example.com",
+				Status:  config.EMAIL_STATUS_NEW,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = tx.Exec(ctx, `UPDATE "notification" SET status = $2, attempts = $3, next_attempt_at = now() - INTERVAL '1 minute' WHERE id = $1`,
+				notification.GetId(), tt.status, tt.attempts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			claimed, err := repo.Claim(ctx, 1000, time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			found := false
+			for _, val := range claimed {
+				if val.GetId() == notification.GetId() {
+					found = true
+				}
+			}
+
+			if found != tt.claimed {
+				t.Errorf("Claim() claimed = %v, want %v", found, tt.claimed)
+			}
+
+			var status string
+			err = tx.QueryRow(ctx, `SELECT status FROM "notification" WHERE id = $1`, notification.GetId()).Scan(&status)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if status != tt.want {
+				t.Errorf("status = %s, want %s", status, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package notification
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/notification_service"
+	"editory_submission/pkg/helper"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"encoding/json"
+	"github.com/google/uuid"
+)
+
+type UserNotificationRepo struct {
+	db models.DB
+}
+
+func NewUserNotificationRepo(db models.DB) storage.UserNotificationRepoI {
+	return &UserNotificationRepo{
+		db: db,
+	}
+}
+
+func (s *UserNotificationRepo) Create(ctx context.Context, req *pb.CreateUserNotificationReq) (res *pb.UserNotification, err error) {
+	res = &pb.UserNotification{}
+
+	query := `INSERT INTO "user_notification" (
+		id,
+		user_id,
+		type,
+		payload
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4::JSONB
+	) RETURNING
+		id,
+		user_id,
+		type,
+		payload::TEXT,
+		COALESCE(TO_CHAR(read_at, ` + config.DatabaseQueryTimeLayout + `), '') AS read_at,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at`
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	payload := req.GetPayload()
+	if payload == nil {
+		payload = map[string]string{}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var resPayload string
+
+	err = s.db.QueryRow(ctx, query,
+		id.String(),
+		req.GetUserId(),
+		req.GetType(),
+		string(body),
+	).Scan(
+		&res.Id,
+		&res.UserId,
+		&res.Type,
+		&resPayload,
+		&res.ReadAt,
+		&res.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal([]byte(resPayload), &res.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetList returns the newest notifications of the user first, unread_count ignores the unread and type filters
+func (s *UserNotificationRepo) GetList(ctx context.Context, req *pb.GetUserNotificationListReq) (res *pb.GetUserNotificationListRes, err error) {
+	res = &pb.GetUserNotificationListRes{}
+	params := make(map[string]interface{})
+	var arr []interface{}
+
+	query := `SELECT
+		id,
+		user_id,
+		type,
+		payload::TEXT,
+		COALESCE(TO_CHAR(read_at, ` + config.DatabaseQueryTimeLayout + `), '') AS read_at,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at
+	FROM
+		"user_notification"`
+	filter := " WHERE user_id = :user_id"
+	params["user_id"] = req.GetUserId()
+
+	offset := " OFFSET 0"
+
+	limit := " LIMIT 10"
+
+	if req.GetUnread() {
+		filter += ` AND read_at IS NULL`
+	}
+
+	if len(req.GetType()) > 0 {
+		params["type"] = req.GetType()
+		filter += ` AND type = :type`
+	}
+
+	if req.Offset > 0 {
+		params["offset"] = req.Offset
+		offset = " OFFSET :offset"
+	}
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+		limit = " LIMIT :limit"
+	}
+
+	cQ := `SELECT
+		count(1),
+		(SELECT count(1) FROM "user_notification" WHERE user_id = :user_id AND read_at IS NULL)
+	FROM "user_notification"` + filter
+
+	cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+		&res.Count,
+		&res.UnreadCount,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + ` ORDER BY created_at DESC` + offset + limit
+
+	q, arr = helper.ReplaceQueryParams(q, params)
+	rows, err := s.db.Query(ctx, q, arr...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.UserNotification{}
+		var payload string
+
+		err = rows.Scan(
+			&obj.Id,
+			&obj.UserId,
+			&obj.Type,
+			&payload,
+			&obj.ReadAt,
+			&obj.CreatedAt,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		err = json.Unmarshal([]byte(payload), &obj.Payload)
+		if err != nil {
+			return res, err
+		}
+
+		res.Notifications = append(res.Notifications, obj)
+	}
+
+	return res, rows.Err()
+}
+
+// MarkRead keeps the first read time of the notification, no rows are affected only when the user has no such notification
+func (s *UserNotificationRepo) MarkRead(ctx context.Context, id, userId string) (rowsAffected int64, err error) {
+	query := `UPDATE "user_notification" SET
+		read_at = COALESCE(read_at, now())
+	WHERE
+		id = $1 AND user_id = $2`
+
+	result, err := s.db.Exec(ctx, query, id, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+func (s *UserNotificationRepo) MarkAllRead(ctx context.Context, userId string) (rowsAffected int64, err error) {
+	query := `UPDATE "user_notification" SET
+		read_at = now()
+	WHERE
+		user_id = $1 AND read_at IS NULL`
+
+	result, err := s.db.Exec(ctx, query, userId)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"editory_submission/config"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/analytics"
 	auth "editory_submission/storage/postgres/auth"
 	content "editory_submission/storage/postgres/content"
+	"editory_submission/storage/postgres/models"
 	"editory_submission/storage/postgres/notification"
 	"editory_submission/storage/postgres/submission"
 	"fmt"
@@ -14,11 +16,13 @@ import (
 )
 
 type Store struct {
-	db           *pgxpool.Pool
+	pool         *pgxpool.Pool
+	db           models.DB
 	auth         storage.AuthRepoI
 	content      storage.ContentRepoI
 	notification storage.NotificationRepoI
 	submission   storage.SubmissionRepoI
+	analytics    storage.AnalyticsRepoI
 }
 
 func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, error) {
@@ -49,12 +53,44 @@ func NewPostgres(ctx context.Context, cfg config.Config) (storage.StorageI, erro
 	}
 
 	return &Store{
-		db: pool,
+		pool: pool,
+		db:   pool,
 	}, err
 }
 
 func (s *Store) CloseDB() {
-	s.db.Close()
+	s.pool.Close()
+}
+
+// WithTx runs fn against a storage bound to a single transaction.
+// Transaction is committed when fn returns nil and rolled back otherwise,
+// nested calls are run in a savepoint of the outer transaction.
+func (s *Store) WithTx(ctx context.Context, fn func(storage.StorageI) error) (err error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	err = fn(&Store{
+		pool: s.pool,
+		db:   tx,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (s *Store) Auth() storage.AuthRepoI {
@@ -88,3 +124,11 @@ func (s *Store) Submission() storage.SubmissionRepoI {
 
 	return s.submission
 }
+
+func (s *Store) Analytics() storage.AnalyticsRepoI {
+	if s.analytics == nil {
+		s.analytics = analytics.NewAnalyticsRepo(s.db)
+	}
+
+	return s.analytics
+}
@@ -7,17 +7,18 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
 type ArticleRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewArticleRepo(db *pgxpool.Pool) storage.ArticleRepoI {
+func NewArticleRepo(db models.DB) storage.ArticleRepoI {
 	return &ArticleRepo{
 		db: db,
 	}
@@ -39,7 +40,8 @@ func (s *ArticleRepo) Create(ctx context.Context, req *pb.CreateArticleReq) (res
         conflict,
         availability,
         funding,
-        draft_step
+        draft_step,
+        revision
 	) VALUES (
 		$1,
 		$2,
@@ -53,7 +55,8 @@ func (s *ArticleRepo) Create(ctx context.Context, req *pb.CreateArticleReq) (res
 		$10,
 		$11,
 		$12,
-		$13
+		$13,
+		$14
 	) RETURNING 
 		id,
 		journal_id,
@@ -70,6 +73,7 @@ func (s *ArticleRepo) Create(ctx context.Context, req *pb.CreateArticleReq) (res
 		COALESCE(editor_status::VARCHAR, '') as editor_status,
 		COALESCE(reviewer_status::VARCHAR, '') as reviewer_status,
 		COALESCE(draft_step, '') as draft_step,
+		revision,
 		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
 		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at`
 
@@ -87,6 +91,10 @@ func (s *ArticleRepo) Create(ctx context.Context, req *pb.CreateArticleReq) (res
 		req.GroupId = groupId.String()
 	}
 
+	if req.GetRevision() <= 0 {
+		req.Revision = 1
+	}
+
 	err = s.db.QueryRow(ctx, query,
 		id.String(),
 		req.GetJournalId(),
@@ -101,6 +109,7 @@ func (s *ArticleRepo) Create(ctx context.Context, req *pb.CreateArticleReq) (res
 		req.GetAvailability(),
 		req.GetFunding(),
 		req.GetDraftStep(),
+		req.GetRevision(),
 	).Scan(
 		&res.Id,
 		&res.JournalId,
@@ -117,6 +126,7 @@ func (s *ArticleRepo) Create(ctx context.Context, req *pb.CreateArticleReq) (res
 		&res.EditorStatus,
 		&res.ReviewerStatus,
 		&res.DraftStep,
+		&res.Revision,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 	)
@@ -149,6 +159,7 @@ func (s *ArticleRepo) Get(ctx context.Context, req *pb.GetArticleReq) (res *pb.G
 		COALESCE(editor_status::VARCHAR, '') as editor_status,
 		COALESCE(reviewer_status::VARCHAR, '') as reviewer_status,
 		COALESCE(draft_step, '') as draft_step,
+		d.revision,
 		TO_CHAR(d.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
 		TO_CHAR(d.updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
 		j.id,
@@ -192,6 +203,7 @@ func (s *ArticleRepo) Get(ctx context.Context, req *pb.GetArticleReq) (res *pb.G
 		&res.EditorStatus,
 		&res.ReviewerStatus,
 		&res.DraftStep,
+		&res.Revision,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		&journal.Id,
@@ -218,6 +230,12 @@ func (s *ArticleRepo) Get(ctx context.Context, req *pb.GetArticleReq) (res *pb.G
 	res.JournalIdData = journal
 	res.AuthorIdData = user
 
+	keywords, err := s.getKeywords(ctx, []string{res.Id})
+	if err != nil {
+		return res, err
+	}
+	res.Keywords = keywords[res.Id]
+
 	return res, nil
 }
 
@@ -236,6 +254,20 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 		config.ARTICLE_STATUS_CORRECTED: true,
 	}
 
+	validDraftStep := map[string]bool{
+		config.DRAFT_STEP_AUTHOR:   true,
+		config.DRAFT_STEP_EDITOR:   true,
+		config.DRAFT_STEP_REVIEWER: true,
+	}
+
+	sortColumns := util.SortColumns{
+		"created_at": "d.created_at",
+		"updated_at": "d.updated_at",
+		"title":      "d.title",
+		"status":     "d.status",
+		"step":       "d.step",
+	}
+
 	query := `SELECT
 		d.id,
 		d.journal_id,
@@ -252,6 +284,7 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 		COALESCE(editor_status::VARCHAR, '') as editor_status,
 		COALESCE(reviewer_status::VARCHAR, '') as reviewer_status,
 		COALESCE(draft_step, '') as draft_step,
+		d.revision,
 		TO_CHAR(d.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
 		TO_CHAR(d.updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
 		j.id,
@@ -264,7 +297,8 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 		COALESCE(u.last_name, ''),
 		COALESCE(u.phone, ''),
 		u.email,
-		TO_CHAR(u.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at
+		TO_CHAR(u.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(d.created_at, ` + config.DatabaseCursorTimeLayout + `) AS cursor
 	FROM
 		"draft" d
 	INNER JOIN "journal" j ON d.journal_id = j.id
@@ -272,7 +306,11 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 
 	filter := ` WHERE 1=1`
 	group := ``
-	order := ` ORDER BY d.created_at DESC`
+	keysetOrder := ` ORDER BY d.created_at DESC, d.id DESC`
+	order, err := util.OrderBy(req.GetSort(), sortColumns, keysetOrder)
+	if err != nil {
+		return res, err
+	}
 
 	if util.IsValidUUID(req.GetJournalId()) {
 		filter += " AND journal_id = :journal_id"
@@ -284,18 +322,40 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 		params["author_id"] = req.GetAuthorId()
 	}
 
-	if _, ok := validArticleStatus[req.GetStatus()]; ok {
-		filter += " AND d.status = :status"
-		params["status"] = req.GetStatus()
+	statusFilter, err := util.AnyOf("d.status", "status", req.GetStatus(), validArticleStatus, params)
+	if err != nil {
+		return res, err
+	}
+	filter += statusFilter
+
+	stepFilter, err := util.AnyOf("d.step", "step", req.GetStep(), validDraftStep, params)
+	if err != nil {
+		return res, err
+	}
+	filter += stepFilter
+
+	createdFilter, err := util.CreatedRange("d.created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
+	if util.IsValidUUID(req.GetKeywordId()) {
+		filter += ` AND EXISTS (SELECT 1 FROM "draft_keyword" dk WHERE dk.draft_id = d.id AND dk.keyword_id = :keyword_id)`
+		params["keyword_id"] = req.GetKeywordId()
 	}
 
 	if util.IsValidUUID(req.GroupId) {
 		filter += " AND group_id = :group_id"
 		params["group_id"] = req.GroupId
+		order, err = util.OrderBy(req.GetSort(), sortColumns, ` ORDER BY d.revision DESC`)
+		if err != nil {
+			return res, err
+		}
 	} else {
 		query += ` INNER JOIN (
 						SELECT DISTINCT
-							FIRST_VALUE(id) OVER (PARTITION BY group_id ORDER BY created_at DESC) AS id
+							FIRST_VALUE(id) OVER (PARTITION BY group_id ORDER BY revision DESC, created_at DESC) AS id
 						FROM "draft"
 					) d2 ON d.id = d2.id`
 		group = ` GROUP BY group_id`
@@ -303,12 +363,12 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 
 	offset := " OFFSET 0"
 
-	limit := " LIMIT 10"
+	pageSize := int32(10)
 
 	if len(req.Search) > 0 {
 		params["search"] = req.Search
-		filter += ` AND ((title ILIKE '%' || :search || '%')
-					OR (description ILIKE '%' || :search || '%'))`
+		filter += ` AND ((d.search_vector @@ multilingual_tsquery(:search))
+					OR (d.title ILIKE '%' || :search || '%'))`
 	}
 
 	if req.Offset > 0 {
@@ -317,24 +377,46 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 	}
 
 	if req.Limit > 0 {
-		params["limit"] = req.Limit
-		limit = " LIMIT :limit"
+		pageSize = req.Limit
 	}
 
-	cQ := `SELECT count(1) over() FROM "draft" d` + filter + group
+	// one more row is fetched to know whether the next page exists
+	params["limit"] = pageSize + 1
+	limit := " LIMIT :limit"
 
-	cQ, arr = helper.ReplaceQueryParams(cQ, params)
+	if len(req.GetCursor()) > 0 {
+		// the cursor pages the keyset order only, a page of another sort would skip or repeat rows
+		if req.GetSort() != "" {
+			return nil, fmt.Errorf("%w: cursor can't be used with sort", helper.ErrInvalidCursor)
+		}
 
-	//fmt.Println(cQ)
+		createdAt, id, err := helper.DecodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, err
+		}
 
-	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
-		&res.Count,
-	)
-	if errors.Is(err, pgx.ErrNoRows) {
-		return res, nil
-	} else if err != nil {
-		return nil, err
+		params["cursor_created_at"] = createdAt
+		params["cursor_id"] = id
+		filter += ` AND (d.created_at, d.id) < (:cursor_created_at::TIMESTAMP, :cursor_id::UUID)`
+		order = keysetOrder
+		offset = ""
+	} else {
+		cQ := `SELECT count(1) over() FROM "draft" d` + filter + group
+
+		cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+		//fmt.Println(cQ)
+
+		err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+			&res.Count,
+		)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return res, nil
+		} else if err != nil {
+			return nil, err
+		}
 	}
+
 	q := query + filter + order + offset + limit
 
 	q, arr = helper.ReplaceQueryParams(q, params)
@@ -347,10 +429,14 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 	}
 	defer rows.Close()
 
+	ids := []string{}
+	cursors := []string{}
+
 	for rows.Next() {
 		obj := &pb.Article{}
 		journal := &pb.Journal{}
 		user := &pb.User{}
+		var cursor string
 
 		err = rows.Scan(
 			&obj.Id,
@@ -368,6 +454,7 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 			&obj.EditorStatus,
 			&obj.ReviewerStatus,
 			&obj.DraftStep,
+			&obj.Revision,
 			&obj.CreatedAt,
 			&obj.UpdatedAt,
 			&journal.Id,
@@ -381,6 +468,7 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 			&user.Phone,
 			&user.Email,
 			&user.CreatedAt,
+			&cursor,
 		)
 		if err != nil {
 			return res, err
@@ -389,6 +477,25 @@ func (s *ArticleRepo) GetList(ctx context.Context, req *pb.GetArticleListReq) (r
 		obj.JournalIdData = journal
 		obj.AuthorIdData = user
 		res.Articles = append(res.Articles, obj)
+		ids = append(ids, obj.Id)
+		cursors = append(cursors, cursor)
+	}
+	rows.Close()
+
+	if int32(len(res.Articles)) > pageSize {
+		res.Articles = res.Articles[:pageSize]
+		if order == keysetOrder {
+			res.NextCursor = helper.EncodeCursor(cursors[pageSize-1], res.Articles[pageSize-1].Id)
+		}
+	}
+
+	keywords, err := s.getKeywords(ctx, ids)
+	if err != nil {
+		return res, err
+	}
+
+	for _, val := range res.Articles {
+		val.Keywords = keywords[val.Id]
 	}
 
 	return res, nil
@@ -490,15 +597,56 @@ func (s *ArticleRepo) Update(ctx context.Context, req *pb.UpdateArticleReq) (row
 		params["funding"] = req.Funding
 	}
 
-	query := querySet + filter
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	event := &pb.DraftEvent{
+		DraftId: req.GetId(),
+		ActorId: req.GetActorId(),
+		Type:    config.DRAFT_EVENT_DRAFT_UPDATE,
+		Comment: req.GetComment(),
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		`SELECT COALESCE(status::VARCHAR, ''), COALESCE(step::VARCHAR, '') FROM "draft" WHERE id = $1 FOR UPDATE`,
+		req.GetId(),
+	).Scan(
+		&event.OldStatus,
+		&event.OldStep,
+	)
+	if err != nil {
+		if util.IsErrNoRows(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	query := querySet + filter + ` RETURNING COALESCE(status::VARCHAR, ''), COALESCE(step::VARCHAR, '')`
 	q, arr := helper.ReplaceQueryParams(query, params)
 
-	result, err := s.db.Exec(ctx, q, arr...)
+	err = tx.QueryRow(ctx, q, arr...).Scan(
+		&event.NewStatus,
+		&event.NewStep,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = NewDraftEventRepo(tx).Create(ctx, event)
 	if err != nil {
 		return 0, err
 	}
 
-	return result.RowsAffected(), err
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return 1, nil
 }
 func (s *ArticleRepo) Delete(ctx context.Context, req *pb.DeleteArticleReq) (rowsAffected int64, err error) {
 	queryArticleDelete := `DELETE FROM "draft" WHERE id = $1`
@@ -512,3 +660,83 @@ func (s *ArticleRepo) Delete(ctx context.Context, req *pb.DeleteArticleReq) (row
 
 	return rowsAffected, err
 }
+
+// IsLatestRevision locks the draft row, so within a transaction concurrent revisions of it are serialized
+func (s *ArticleRepo) IsLatestRevision(ctx context.Context, id string) (isLatest bool, err error) {
+	query := `SELECT NOT EXISTS (
+		SELECT 1 FROM "draft" r
+		WHERE r.group_id = d.group_id AND r.revision > d.revision
+	) FROM "draft" d WHERE d.id = $1 FOR UPDATE OF d`
+
+	err = s.db.QueryRow(ctx, query, id).Scan(&isLatest)
+	if err != nil {
+		return false, err
+	}
+
+	return isLatest, nil
+}
+
+// SetKeywords replaces the draft keywords
+func (s *ArticleRepo) SetKeywords(ctx context.Context, draftId string, keywordIds []string) error {
+	_, err := s.db.Exec(ctx, `DELETE FROM "draft_keyword" WHERE draft_id = $1`, draftId)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO "draft_keyword" (
+		draft_id,
+		keyword_id
+	) VALUES (
+		$1,
+		$2
+	) ON CONFLICT DO NOTHING`
+
+	for _, val := range keywordIds {
+		_, err = s.db.Exec(ctx, query, draftId, val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getKeywords returns the keywords of the drafts by draft id
+func (s *ArticleRepo) getKeywords(ctx context.Context, draftIds []string) (map[string][]*pb.Keyword, error) {
+	res := make(map[string][]*pb.Keyword)
+
+	query := `SELECT
+		dk.draft_id,
+		k.id,
+		k.word
+	FROM
+		"draft_keyword" dk
+	INNER JOIN "keyword" k ON k.id = dk.keyword_id
+	WHERE
+		dk.draft_id::VARCHAR = ANY($1)
+	ORDER BY k.word`
+
+	rows, err := s.db.Query(ctx, query, draftIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var draftId string
+		obj := &pb.Keyword{}
+
+		err = rows.Scan(
+			&draftId,
+			&obj.Id,
+			&obj.Title,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res[draftId] = append(res[draftId], obj)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,54 @@
+package submission
+
+import (
+	"editory_submission/config"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/helper"
+	"errors"
+	"testing"
+)
+
+func TestArticleRepoGetListCursor(t *testing.T) {
+	tests := []struct {
+		name string
+		sort string
+		err  error
+	}{
+		{name: "cursor pages the keyset order"},
+		{name: "cursor with sort", sort: "title:asc", err: helper.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, tx := testTx(t)
+
+			author := createTestUser(ctx, t, tx)
+			createTestDraft(ctx, t, tx, author, config.ARTICLE_STATUS_DRAFT, config.DRAFT_STEP_AUTHOR)
+			createTestDraft(ctx, t, tx, author, config.ARTICLE_STATUS_DRAFT, config.DRAFT_STEP_AUTHOR)
+
+			repo := NewArticleRepo(tx)
+
+			first, err := repo.GetList(ctx, &pb.GetArticleListReq{
+				AuthorId: author,
+				Limit:    1,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if first.GetNextCursor() == "" {
+				t.Fatal("no cursor of the next page")
+			}
+
+			_, err = repo.GetList(ctx, &pb.GetArticleListReq{
+				AuthorId: author,
+				Limit:    1,
+				Cursor:   first.GetNextCursor(),
+				Sort:     tt.sort,
+			})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("GetList() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -7,16 +7,19 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"fmt"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 )
 
+// overdueCondition matches reviews which passed the deadline and are still not done
+const overdueCondition = `(r.overdue_at IS NOT NULL AND r.status IN ('NEW', 'PENDING'))`
+
 type ReviewerRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewReviewerRepo(db *pgxpool.Pool) storage.ReviewerRepoI {
+func NewReviewerRepo(db models.DB) storage.ReviewerRepoI {
 	return &ReviewerRepo{
 		db: db,
 	}
@@ -32,14 +35,20 @@ func (s *ReviewerRepo) Create(ctx context.Context, req *pb.CreateArticleCheckerR
         draft_id,
         status,
     	type,
-        comment
+        comment,
+        due_at,
+        conflict_override_reason,
+        conflict_override_by
 	) VALUES (
 		$1,
 		$2,
 		$3,
 		$4,
 		$5,
-		$6
+		$6,
+		$7,
+		$8,
+		$9
 	) RETURNING 
 	    id, 
 	    checker_id,
@@ -47,21 +56,42 @@ func (s *ReviewerRepo) Create(ctx context.Context, req *pb.CreateArticleCheckerR
         status,
 	    type,
         COALESCE(comment, '') as comment,
+        COALESCE(TO_CHAR(due_at, ` + config.DatabaseQueryTimeLayout + `), '') AS due_at,
+        COALESCE(TO_CHAR(overdue_at, ` + config.DatabaseQueryTimeLayout + `), '') AS overdue_at,
         TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
-        TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at`
+        TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
+        COALESCE(conflict_override_reason, ''),
+        COALESCE(conflict_override_by::VARCHAR, '')`
 
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.db.QueryRow(ctx, query,
+	res.Conflict = &pb.ConflictOfInterest{}
+
+	// the editor is recorded only for the assignments made despite a conflict of interest
+	overrideBy := ""
+	if req.GetConflictOverrideReason() != "" {
+		overrideBy = req.GetEditorId()
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, query,
 		id.String(),
 		req.CheckerId,
 		req.ArticleId,
 		req.Status,
 		req.Type,
 		util.NewNullString(req.Comment),
+		util.NewNullString(req.DueAt),
+		util.NewNullString(req.ConflictOverrideReason),
+		util.NewNullString(overrideBy),
 	).Scan(
 		&res.Id,
 		&res.CheckerId,
@@ -69,8 +99,12 @@ func (s *ReviewerRepo) Create(ctx context.Context, req *pb.CreateArticleCheckerR
 		&res.Status,
 		&res.Type,
 		&res.Comment,
+		&res.DueAt,
+		&res.OverdueAt,
 		&res.CreatedAt,
 		&res.UpdatedAt,
+		&res.Conflict.OverrideReason,
+		&res.Conflict.OverrideBy,
 	)
 	if err != nil {
 		return nil, err
@@ -96,7 +130,7 @@ func (s *ReviewerRepo) Create(ctx context.Context, req *pb.CreateArticleCheckerR
 			return nil, err
 		}
 
-		_, err = s.db.Exec(
+		_, err = tx.Exec(
 			ctx,
 			queryComment,
 			commentId.String(),
@@ -106,11 +140,26 @@ func (s *ReviewerRepo) Create(ctx context.Context, req *pb.CreateArticleCheckerR
 			val.Comment,
 		)
 		if err != nil {
-			fmt.Printf("Checker------>Create: %s", err.Error())
-			continue
+			return nil, err
 		}
 	}
 
+	_, err = NewDraftEventRepo(tx).Create(ctx, &pb.DraftEvent{
+		DraftId:   res.GetArticleId(),
+		ActorId:   req.GetEditorId(),
+		Type:      config.DRAFT_EVENT_CHECKER_CREATE,
+		NewStatus: res.GetStatus(),
+		Comment:   res.GetComment(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return res, nil
 }
 
@@ -118,6 +167,8 @@ func (s *ReviewerRepo) Get(ctx context.Context, req *pb.GetArticleCheckerReq) (r
 	res = &pb.GetArticleCheckerRes{}
 	article := &pb.Article{}
 	user := &pb.Checker{}
+	invitation := &pb.ReviewerInvitation{}
+	conflict := &pb.ConflictOfInterest{}
 
 	query := `SELECT
 		r.id, 
@@ -126,6 +177,8 @@ func (s *ReviewerRepo) Get(ctx context.Context, req *pb.GetArticleCheckerReq) (r
         r.status,
 	    r.type,
         COALESCE(r.comment, '') as comment,
+        COALESCE(TO_CHAR(r.due_at, ` + config.DatabaseQueryTimeLayout + `), '') AS due_at,
+        COALESCE(TO_CHAR(r.overdue_at, ` + config.DatabaseQueryTimeLayout + `), '') AS overdue_at,
         TO_CHAR(r.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
         TO_CHAR(r.updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
 		a.id,                 
@@ -143,11 +196,26 @@ func (s *ReviewerRepo) Get(ctx context.Context, req *pb.GetArticleCheckerReq) (r
 		u.id,
 		COALESCE(u.first_name, ''),
 		COALESCE(u.last_name, ''),
-		u.email
+		u.email,
+		COALESCE(i.id::VARCHAR, ''),
+		COALESCE(i.status::VARCHAR, ''),
+		COALESCE(TO_CHAR(i.expires_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(TO_CHAR(i.responded_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(TO_CHAR(i.created_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(TO_CHAR(i.updated_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(j.review_mode::VARCHAR, ''),
+		r.conflict_declared_at IS NOT NULL,
+		r.has_conflict,
+		COALESCE(r.conflict_statement, ''),
+		COALESCE(TO_CHAR(r.conflict_declared_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(r.conflict_override_reason, ''),
+		COALESCE(r.conflict_override_by::VARCHAR, '')
 	FROM
 		"draft_checker" r
 	INNER JOIN "draft" a ON r.draft_id = a.id
 	INNER JOIN "user" u ON r.checker_id = u.id
+	LEFT JOIN "reviewer_invitation" i ON i.draft_checker_id = r.id
+	LEFT JOIN "journal" j ON a.journal_id = j.id
 	WHERE
 		r.id = $1`
 
@@ -161,6 +229,8 @@ func (s *ReviewerRepo) Get(ctx context.Context, req *pb.GetArticleCheckerReq) (r
 		&res.Status,
 		&res.Type,
 		&res.Comment,
+		&res.DueAt,
+		&res.OverdueAt,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		&article.Id,
@@ -179,12 +249,30 @@ func (s *ReviewerRepo) Get(ctx context.Context, req *pb.GetArticleCheckerReq) (r
 		&user.FirstName,
 		&user.LastName,
 		&user.Email,
+		&invitation.Id,
+		&invitation.Status,
+		&invitation.ExpiresAt,
+		&invitation.RespondedAt,
+		&invitation.CreatedAt,
+		&invitation.UpdatedAt,
+		&res.ReviewMode,
+		&conflict.Declared,
+		&conflict.HasConflict,
+		&conflict.Statement,
+		&conflict.DeclaredAt,
+		&conflict.OverrideReason,
+		&conflict.OverrideBy,
 	)
 
 	if err != nil {
 		return res, err
 	}
 
+	if invitation.Id != "" {
+		invitation.DraftCheckerId = res.Id
+		res.Invitation = invitation
+	}
+
 	queryComment := `SELECT
 		c.id,                            
     	c.type,
@@ -233,6 +321,50 @@ func (s *ReviewerRepo) Get(ctx context.Context, req *pb.GetArticleCheckerReq) (r
 	res.Comments = comments
 	res.ArticleIdData = article
 	res.CheckerIdData = user
+	res.Conflict = conflict
+
+	queryAnswer := `SELECT
+		a.id,
+		a.draft_checker_id,
+		a.question_id,
+		a.value,
+		q.text,
+		q.type,
+		q.required
+	FROM
+		"draft_checker_answer" a
+	INNER JOIN "review_form_question" q ON a.question_id = q.id
+	WHERE
+		a.draft_checker_id = $1
+	ORDER BY q.position, q.created_at`
+
+	answerRows, err := s.db.Query(
+		ctx,
+		queryAnswer,
+		req.GetId(),
+	)
+	if err != nil {
+		return res, err
+	}
+	defer answerRows.Close()
+
+	for answerRows.Next() {
+		obj := &pb.ReviewAnswer{}
+		err = answerRows.Scan(
+			&obj.Id,
+			&obj.DraftCheckerId,
+			&obj.QuestionId,
+			&obj.Value,
+			&obj.QuestionText,
+			&obj.QuestionType,
+			&obj.Required,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		res.Answers = append(res.Answers, obj)
+	}
 
 	return res, nil
 }
@@ -246,6 +378,7 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 		config.ARTICLE_REVIEWER_STATUS_REJECTED:            true,
 		config.ARTICLE_REVIEWER_STATUS_APPROVED:            true,
 		config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION: true,
+		config.ARTICLE_REVIEWER_STATUS_DECLINED:            true,
 	}
 
 	validCheckerType := map[string]bool{
@@ -256,6 +389,12 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 	params := make(map[string]interface{})
 	var arr []interface{}
 
+	sortColumns := util.SortColumns{
+		"created_at": "r.created_at",
+		"due_at":     "r.due_at",
+		"status":     "r.status",
+	}
+
 	query := `SELECT
 		r.id, 
 	    r.checker_id,
@@ -263,6 +402,8 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
         r.status,
 	    r.type,
         COALESCE(r.comment, '') as comment,
+        COALESCE(TO_CHAR(r.due_at, ` + config.DatabaseQueryTimeLayout + `), '') AS due_at,
+        COALESCE(TO_CHAR(r.overdue_at, ` + config.DatabaseQueryTimeLayout + `), '') AS overdue_at,
         TO_CHAR(r.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
         TO_CHAR(r.updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at,
 		a.id,                 
@@ -280,22 +421,40 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 		u.id,
 		COALESCE(u.first_name, ''),
 		COALESCE(u.last_name, ''),
-		u.email
+		u.email,
+		COALESCE(i.id::VARCHAR, ''),
+		COALESCE(i.status::VARCHAR, ''),
+		COALESCE(TO_CHAR(i.expires_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(TO_CHAR(i.responded_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(TO_CHAR(i.created_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(TO_CHAR(i.updated_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(j.review_mode::VARCHAR, ''),
+		r.conflict_declared_at IS NOT NULL,
+		r.has_conflict,
+		COALESCE(r.conflict_statement, ''),
+		COALESCE(TO_CHAR(r.conflict_declared_at, ` + config.DatabaseQueryTimeLayout + `), ''),
+		COALESCE(r.conflict_override_reason, ''),
+		COALESCE(r.conflict_override_by::VARCHAR, '')
 	FROM
 		"draft_checker" r
 	INNER JOIN "draft" a ON r.draft_id = a.id
-	INNER JOIN "user" u ON r.checker_id = u.id`
+	INNER JOIN "user" u ON r.checker_id = u.id
+	LEFT JOIN "reviewer_invitation" i ON i.draft_checker_id = r.id
+	LEFT JOIN "journal" j ON a.journal_id = j.id`
 	filter := " WHERE 1=1"
 
 	offset := " OFFSET 0"
 
 	limit := " LIMIT 10"
 
-	orderBy := ` ORDER BY r.created_at DESC`
+	orderBy, err := util.OrderBy(req.GetSort(), sortColumns, " ORDER BY r.created_at DESC")
+	if err != nil {
+		return res, err
+	}
 
 	if len(req.Search) > 0 {
 		params["search"] = req.Search
-		filter += ` AND (status ILIKE '%' || :search || '%')`
+		filter += ` AND (r.status::VARCHAR ILIKE '%' || :search || '%')`
 	}
 
 	if util.IsValidUUID(req.CheckerId) {
@@ -318,6 +477,15 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 		filter += ` AND r.status = :status`
 	}
 
+	if util.IsValidUUID(req.JournalId) {
+		params["journal_id"] = req.JournalId
+		filter += ` AND r.draft_id IN (SELECT id FROM "draft" WHERE journal_id = :journal_id)`
+	}
+
+	if req.Overdue {
+		filter += ` AND ` + overdueCondition
+	}
+
 	if req.Offset > 0 {
 		params["offset"] = req.Offset
 		offset = " OFFSET :offset"
@@ -328,12 +496,19 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 		limit = " LIMIT :limit"
 	}
 
-	cQ := `SELECT count(1) FROM "draft_checker" r` + filter
+	createdFilter, err := util.CreatedRange("r.created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
+	cQ := `SELECT count(1), count(1) FILTER (WHERE ` + overdueCondition + `) FROM "draft_checker" r` + filter
 
 	cQ, arr = helper.ReplaceQueryParams(cQ, params)
 
 	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
 		&res.Count,
+		&res.OverdueCount,
 	)
 	if err != nil {
 		return res, err
@@ -352,6 +527,8 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 		obj := &pb.GetArticleCheckerListRes_ArticleChecker{}
 		article := &pb.Article{}
 		user := &pb.Checker{}
+		invitation := &pb.ReviewerInvitation{}
+		conflict := &pb.ConflictOfInterest{}
 
 		err = rows.Scan(
 			&obj.Id,
@@ -360,6 +537,8 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 			&obj.Status,
 			&obj.Type,
 			&obj.Comment,
+			&obj.DueAt,
+			&obj.OverdueAt,
 			&obj.CreatedAt,
 			&obj.UpdatedAt,
 			&article.Id,
@@ -378,13 +557,32 @@ func (s *ReviewerRepo) GetList(ctx context.Context, req *pb.GetArticleCheckerLis
 			&user.FirstName,
 			&user.LastName,
 			&user.Email,
+			&invitation.Id,
+			&invitation.Status,
+			&invitation.ExpiresAt,
+			&invitation.RespondedAt,
+			&invitation.CreatedAt,
+			&invitation.UpdatedAt,
+			&obj.ReviewMode,
+			&conflict.Declared,
+			&conflict.HasConflict,
+			&conflict.Statement,
+			&conflict.DeclaredAt,
+			&conflict.OverrideReason,
+			&conflict.OverrideBy,
 		)
 		if err != nil {
 			return res, err
 		}
 
+		if invitation.Id != "" {
+			invitation.DraftCheckerId = obj.Id
+			obj.Invitation = invitation
+		}
+
 		obj.ArticleIdData = article
 		obj.CheckerIdData = user
+		obj.Conflict = conflict
 
 		res.ArticleCheckers = append(res.ArticleCheckers, obj)
 	}
@@ -400,6 +598,7 @@ func (s *ReviewerRepo) Update(ctx context.Context, req *pb.UpdateArticleCheckerR
 		config.ARTICLE_REVIEWER_STATUS_APPROVED:            true,
 		config.ARTICLE_REVIEWER_STATUS_REJECTED:            true,
 		config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION: true,
+		config.ARTICLE_REVIEWER_STATUS_DECLINED:            true,
 	}
 
 	querySet := `UPDATE "draft_checker" SET                
@@ -411,8 +610,10 @@ func (s *ReviewerRepo) Update(ctx context.Context, req *pb.UpdateArticleCheckerR
 		"id": req.GetId(),
 	}
 
+	// completed_at keeps the first submission of the review, so later edits don't change the review turnaround
 	if _, ok := validArticleReviewerStatus[req.Status]; ok {
-		querySet += `, status = :status`
+		querySet += `, status = :status,
+			completed_at = CASE WHEN :status IN ` + completedReviewStatuses + ` THEN COALESCE(completed_at, CURRENT_TIMESTAMP) END`
 		params["status"] = req.Status
 	}
 
@@ -421,14 +622,52 @@ func (s *ReviewerRepo) Update(ctx context.Context, req *pb.UpdateArticleCheckerR
 		params["comment"] = req.Comment
 	}
 
+	// new deadline starts reminders and overdue tracking over
+	if req.DueAt != "" {
+		querySet += `, due_at = :due_at, reminded_at = NULL, overdue_at = NULL`
+		params["due_at"] = req.DueAt
+	}
+
 	query := querySet + filter
 
-	q, arr := helper.ReplaceQueryParams(query, params)
-	result, err := s.db.Exec(ctx, q, arr...)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	event := &pb.DraftEvent{
+		ActorId: req.GetActorId(),
+		Type:    config.DRAFT_EVENT_CHECKER_UPDATE,
+		Comment: req.GetComment(),
+	}
+
+	err = tx.QueryRow(
+		ctx,
+		`SELECT draft_id, COALESCE(status::VARCHAR, '') FROM "draft_checker" WHERE id = $1 FOR UPDATE`,
+		req.GetId(),
+	).Scan(
+		&event.DraftId,
+		&event.OldStatus,
+	)
+	if err != nil {
+		if util.IsErrNoRows(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	q, arr := helper.ReplaceQueryParams(query+` RETURNING COALESCE(status::VARCHAR, '')`, params)
+	err = tx.QueryRow(ctx, q, arr...).Scan(&event.NewStatus)
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected++
+
+	_, err = NewDraftEventRepo(tx).Create(ctx, event)
 	if err != nil {
 		return 0, err
 	}
-	rowsAffected += result.RowsAffected()
 
 	queryCommentInsert := `INSERT INTO "file_comment" (
 		id,
@@ -451,26 +690,24 @@ func (s *ReviewerRepo) Update(ctx context.Context, req *pb.UpdateArticleCheckerR
 
 	for _, val := range req.GetComments() {
 		if util.IsValidUUID(val.Id) {
-			c, err := s.db.Exec(
+			c, err := tx.Exec(
 				ctx,
 				queryCommentUpdate,
 				val.Comment,
 				val.Id,
 			)
 			if err != nil {
-				fmt.Printf("Checker------>Update: %s", err.Error())
-				continue
+				return 0, err
 			}
 
 			rowsAffected += c.RowsAffected()
 		} else {
 			commentId, err := uuid.NewRandom()
 			if err != nil {
-				fmt.Printf("Checker--------->Create: %s", err.Error())
-				continue
+				return 0, err
 			}
 
-			c, err := s.db.Exec(
+			c, err := tx.Exec(
 				ctx,
 				queryCommentInsert,
 				commentId.String(),
@@ -480,20 +717,78 @@ func (s *ReviewerRepo) Update(ctx context.Context, req *pb.UpdateArticleCheckerR
 				val.Comment,
 			)
 			if err != nil {
-				fmt.Printf("Checker------>Create: %s", err.Error())
-				continue
+				return 0, err
 			}
 
 			rowsAffected += c.RowsAffected()
 		}
 	}
 
+	queryAnswerUpsert := `INSERT INTO "draft_checker_answer" (
+		id,
+		draft_checker_id,
+		question_id,
+		value
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4
+	) ON CONFLICT (draft_checker_id, question_id) DO UPDATE SET
+		value = EXCLUDED.value,
+		updated_at = CURRENT_TIMESTAMP`
+
+	for _, val := range req.GetAnswers() {
+		answerId, err := uuid.NewRandom()
+		if err != nil {
+			return 0, err
+		}
+
+		c, err := tx.Exec(
+			ctx,
+			queryAnswerUpsert,
+			answerId.String(),
+			req.GetId(),
+			val.GetQuestionId(),
+			val.GetValue(),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		rowsAffected += c.RowsAffected()
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
 	return rowsAffected, nil
 }
 func (s *ReviewerRepo) Delete(ctx context.Context, req *pb.DeleteArticleCheckerReq) (rowsAffected int64, err error) {
-	query := `DELETE FROM "draft_checker" WHERE id = $1 OR checker_id = $1`
+	query := `DELETE FROM "draft_checker" WHERE (id = $1 OR checker_id = $1) AND ($2 = '' OR draft_id::VARCHAR = $2)`
+
+	result, err := s.db.Exec(ctx, query, req.GetId(), req.GetArticleId())
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected = result.RowsAffected()
+
+	return rowsAffected, err
+}
 
-	result, err := s.db.Exec(ctx, query, req.GetId())
+// DeclareConflict stores the reviewer conflict of interest declaration
+func (s *ReviewerRepo) DeclareConflict(ctx context.Context, id string, hasConflict bool, statement string) (rowsAffected int64, err error) {
+	query := `UPDATE "draft_checker" SET
+		conflict_declared_at = CURRENT_TIMESTAMP,
+		has_conflict = $2,
+		conflict_statement = $3,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE id = $1`
+
+	result, err := s.db.Exec(ctx, query, id, hasConflict, util.NewNullString(statement))
 	if err != nil {
 		return 0, err
 	}
@@ -502,3 +797,245 @@ func (s *ReviewerRepo) Delete(ctx context.Context, req *pb.DeleteArticleCheckerR
 
 	return rowsAffected, err
 }
+
+// GetConflicts returns why the user can't review the draft: being its author, a coauthor
+// or from the same university as one of them
+func (s *ReviewerRepo) GetConflicts(ctx context.Context, draftId, checkerId string) (conflicts []string, err error) {
+	query := `WITH draft_author AS (
+		SELECT d.author_id AS user_id FROM "draft" d WHERE d.id = $1 AND d.author_id IS NOT NULL
+		UNION
+		SELECT c.user_id FROM "coauthor" c WHERE c.article_id = $1 AND c.user_id IS NOT NULL
+	) SELECT
+		EXISTS (SELECT 1 FROM "draft" d WHERE d.id = $1 AND d.author_id = $2),
+		EXISTS (SELECT 1 FROM "coauthor" c WHERE c.article_id = $1 AND c.user_id = $2),
+		EXISTS (
+			SELECT 1 FROM "user" u
+			INNER JOIN "user" au ON au.university_id = u.university_id
+			WHERE u.id = $2 AND au.id <> u.id AND au.id IN (SELECT user_id FROM draft_author)
+		)`
+
+	var isAuthor, isCoAuthor, sameUniversity bool
+
+	err = s.db.QueryRow(ctx, query, draftId, checkerId).Scan(
+		&isAuthor,
+		&isCoAuthor,
+		&sameUniversity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if isAuthor {
+		conflicts = append(conflicts, "reviewer is the author of the draft")
+	}
+
+	if isCoAuthor {
+		conflicts = append(conflicts, "reviewer is a coauthor of the draft")
+	}
+
+	if sameUniversity {
+		conflicts = append(conflicts, "reviewer is from the same university as an author of the draft")
+	}
+
+	return conflicts, nil
+}
+
+// MarkReminded marks the reviews due within remindDays and returns the ones which weren't reminded yet
+func (s *ReviewerRepo) MarkReminded(ctx context.Context, remindDays int) (res []*models.ReviewDeadline, err error) {
+	query := `UPDATE "draft_checker" r SET
+		reminded_at = CURRENT_TIMESTAMP
+	FROM "draft" d, "user" u
+	WHERE d.id = r.draft_id AND u.id = r.checker_id
+		AND r.type = $1 AND r.status = $2
+		AND r.reminded_at IS NULL AND r.overdue_at IS NULL
+		AND r.due_at > CURRENT_TIMESTAMP
+		AND r.due_at <= CURRENT_TIMESTAMP + make_interval(days => $3)` + reviewDeadlineReturning
+
+	return s.markDeadlines(ctx, query, config.REVIEWER, config.ARTICLE_REVIEWER_STATUS_PENDING, remindDays)
+}
+
+// MarkOverdue marks the reviews which passed the deadline and returns the ones which weren't marked yet
+func (s *ReviewerRepo) MarkOverdue(ctx context.Context) (res []*models.ReviewDeadline, err error) {
+	query := `UPDATE "draft_checker" r SET
+		overdue_at = CURRENT_TIMESTAMP
+	FROM "draft" d, "user" u
+	WHERE d.id = r.draft_id AND u.id = r.checker_id
+		AND r.type = $1 AND r.status IN ($2, $3)
+		AND r.overdue_at IS NULL
+		AND r.due_at <= CURRENT_TIMESTAMP` + reviewDeadlineReturning
+
+	return s.markDeadlines(ctx, query, config.REVIEWER, config.ARTICLE_REVIEWER_STATUS_NEW, config.ARTICLE_REVIEWER_STATUS_PENDING)
+}
+
+const reviewDeadlineReturning = ` RETURNING
+		r.id,
+		r.draft_id,
+		COALESCE(d.journal_id::VARCHAR, ''),
+		r.checker_id,
+		COALESCE(d.title, ''),
+		TO_CHAR(r.due_at, ` + config.DatabaseQueryTimeLayout + `) AS due_at,
+		TRIM(COALESCE(u.first_name, '') || ' ' || COALESCE(u.last_name, '')),
+		COALESCE(u.email, '')`
+
+func (s *ReviewerRepo) markDeadlines(ctx context.Context, query string, args ...interface{}) (res []*models.ReviewDeadline, err error) {
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &models.ReviewDeadline{}
+
+		err = rows.Scan(
+			&obj.CheckerId,
+			&obj.DraftId,
+			&obj.JournalId,
+			&obj.ReviewerId,
+			&obj.DraftTitle,
+			&obj.DueAt,
+			&obj.ReviewerName,
+			&obj.ReviewerEmail,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
+
+// GetSuggestions ranks the journal reviewers by the keywords they share with the draft and by their keywords
+// found in the journal subjects, divided by the reviews they already have in progress. Authors, coauthors,
+// their university colleagues and reviewers already assigned to the draft are left out
+func (s *ReviewerRepo) GetSuggestions(ctx context.Context, req *pb.GetReviewerSuggestionsReq) (res *pb.GetReviewerSuggestionsRes, err error) {
+	res = &pb.GetReviewerSuggestionsRes{}
+	var arr []interface{}
+
+	params := map[string]interface{}{
+		"draft_id":     req.GetDraftId(),
+		"role_type":    config.REVIEWER,
+		"checker_type": config.REVIEWER,
+	}
+
+	with := `WITH draft_author AS (
+		SELECT d.author_id AS user_id FROM "draft" d WHERE d.id = :draft_id AND d.author_id IS NOT NULL
+		UNION
+		SELECT c.user_id FROM "coauthor" c WHERE c.article_id = :draft_id AND c.user_id IS NOT NULL
+	), candidate AS (
+		SELECT
+			u.id,
+			u.email,
+			COALESCE(u.first_name, '') AS first_name,
+			COALESCE(u.last_name, '') AS last_name,
+			ARRAY(
+				SELECT k.word FROM "user_keyword" uk
+				INNER JOIN "draft_keyword" dk ON dk.draft_id = d.id AND dk.keyword_id = uk.keyword_id
+				INNER JOIN "keyword" k ON k.id = uk.keyword_id
+				WHERE uk.user_id = u.id
+				ORDER BY k.word
+			) AS keywords,
+			(
+				SELECT count(1) FROM "user_keyword" uk
+				INNER JOIN "draft_keyword" dk ON dk.draft_id = d.id AND dk.keyword_id = uk.keyword_id
+				WHERE uk.user_id = u.id
+			) AS keyword_matches,
+			(
+				SELECT count(1) FROM "user_keyword" uk
+				INNER JOIN "keyword" k ON k.id = uk.keyword_id
+				INNER JOIN "journal_subject" js ON js.journal_id = d.journal_id
+				INNER JOIN "subject" sb ON sb.id = js.subject_id
+				WHERE uk.user_id = u.id AND sb.title ILIKE '%' || k.word || '%'
+			) AS subject_matches,
+			(
+				SELECT count(1) FROM "draft_checker" r
+				WHERE r.checker_id = u.id AND r.type = :checker_type AND r.status IN ('NEW', 'PENDING')
+			) AS active_reviews
+		FROM "draft" d
+		INNER JOIN "role" ro ON ro.journal_id = d.journal_id AND ro.role_type = :role_type
+		INNER JOIN "user" u ON u.id = ro.user_id
+		WHERE d.id = :draft_id
+			AND u.id NOT IN (SELECT user_id FROM draft_author)
+			AND (u.university_id IS NULL OR u.university_id NOT IN (
+				SELECT au.university_id FROM "user" au
+				WHERE au.id IN (SELECT user_id FROM draft_author) AND au.university_id IS NOT NULL
+			))
+			AND NOT EXISTS (SELECT 1 FROM "draft_checker" r WHERE r.draft_id = d.id AND r.checker_id = u.id)
+	)`
+
+	query := with + ` SELECT
+		id,
+		email,
+		first_name,
+		last_name,
+		keywords,
+		keyword_matches,
+		subject_matches,
+		active_reviews,
+		(2 * keyword_matches + subject_matches)::FLOAT / (1 + active_reviews) AS score
+	FROM candidate`
+
+	orderBy := ` ORDER BY score DESC, active_reviews, last_name, first_name`
+
+	offset := " OFFSET 0"
+
+	limit := " LIMIT 10"
+
+	if req.Offset > 0 {
+		params["offset"] = req.Offset
+		offset = " OFFSET :offset"
+	}
+
+	if req.Limit > 0 {
+		params["limit"] = req.Limit
+		limit = " LIMIT :limit"
+	}
+
+	cQ := with + ` SELECT count(1) FROM candidate`
+
+	cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+		&res.Count,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	q := query + orderBy + offset + limit
+
+	q, arr = helper.ReplaceQueryParams(q, params)
+	rows, err := s.db.Query(ctx, q, arr...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.GetReviewerSuggestionsRes_Suggestion{}
+		user := &pb.Checker{}
+
+		err = rows.Scan(
+			&user.Id,
+			&user.Email,
+			&user.FirstName,
+			&user.LastName,
+			&obj.Keywords,
+			&obj.KeywordMatches,
+			&obj.SubjectMatches,
+			&obj.ActiveReviews,
+			&obj.Score,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		obj.CheckerIdData = user
+
+		res.Suggestions = append(res.Suggestions, obj)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,124 @@
+package submission
+
+import (
+	"editory_submission/config"
+	pb "editory_submission/genproto/submission_service"
+	"testing"
+)
+
+func TestReviewerRepoRecordsActor(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   bool
+		byEditor bool
+	}{
+		{name: "assignment is recorded as done by the editor"},
+		{name: "editor change of the review is recorded as done by the editor", update: true, byEditor: true},
+		{name: "reviewer change of the review is recorded as done by the reviewer", update: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, tx := testTx(t)
+
+			author := createTestUser(ctx, t, tx)
+			editor := createTestUser(ctx, t, tx)
+			reviewer := createTestUser(ctx, t, tx)
+			draftId := createTestDraft(ctx, t, tx, author, config.ARTICLE_STATUS_PENDING, config.DRAFT_STEP_REVIEWER)
+
+			repo := NewReviewerRepo(tx)
+
+			checker, err := repo.Create(ctx, &pb.CreateArticleCheckerReq{
+				CheckerId: reviewer,
+				ArticleId: draftId,
+				Status:    config.ARTICLE_REVIEWER_STATUS_NEW,
+				Type:      config.REVIEWER,
+				EditorId:  editor,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := editor
+			eventType := config.DRAFT_EVENT_CHECKER_CREATE
+
+			if tt.update {
+				actor := reviewer
+				if tt.byEditor {
+					actor = editor
+				}
+
+				_, err = repo.Update(ctx, &pb.UpdateArticleCheckerReq{
+					Id:      checker.GetId(),
+					Status:  config.ARTICLE_REVIEWER_STATUS_PENDING,
+					ActorId: actor,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				want = actor
+				eventType = config.DRAFT_EVENT_CHECKER_UPDATE
+			}
+
+			// the events of one transaction share created_at, so they are found by the type
+			var got *pb.DraftEvent
+			for _, val := range draftEvents(ctx, t, tx, draftId) {
+				if val.GetType() == eventType {
+					got = val
+				}
+			}
+
+			if got == nil {
+				t.Fatalf("no %s event recorded", eventType)
+			}
+
+			if got.GetActorId() != want {
+				t.Errorf("%s event actor = %s, want %s", eventType, got.GetActorId(), want)
+			}
+		})
+	}
+}
+
+func TestReviewerRepoCreateRecordsOverrideOnlyWithReason(t *testing.T) {
+	tests := []struct {
+		name       string
+		reason     string
+		overridden bool
+	}{
+		{name: "no conflict override"},
+		{name: "conflict override", reason: "the only expert in the field", overridden: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, tx := testTx(t)
+
+			author := createTestUser(ctx, t, tx)
+			editor := createTestUser(ctx, t, tx)
+			reviewer := createTestUser(ctx, t, tx)
+			draftId := createTestDraft(ctx, t, tx, author, config.ARTICLE_STATUS_PENDING, config.DRAFT_STEP_REVIEWER)
+
+			res, err := NewReviewerRepo(tx).Create(ctx, &pb.CreateArticleCheckerReq{
+				CheckerId:              reviewer,
+				ArticleId:              draftId,
+				Status:                 config.ARTICLE_REVIEWER_STATUS_NEW,
+				Type:                   config.REVIEWER,
+				EditorId:               editor,
+				ConflictOverrideReason: tt.reason,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := ""
+			if tt.overridden {
+				want = editor
+			}
+
+			if got := res.GetConflict().GetOverrideBy(); got != want {
+				t.Errorf("conflict override by = %q, want %q", got, want)
+			}
+		})
+	}
+}
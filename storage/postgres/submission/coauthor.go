@@ -6,16 +6,16 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"strings"
 )
 
 type CoAuthorRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewCoAuthorRepo(db *pgxpool.Pool) storage.CoAuthorRepoI {
+func NewCoAuthorRepo(db models.DB) storage.CoAuthorRepoI {
 	return &CoAuthorRepo{
 		db: db,
 	}
@@ -135,12 +135,17 @@ func (s CoAuthorRepo) GetList(ctx context.Context, req *pb.GetCoAuthorsReq) (res
 }
 
 func (s CoAuthorRepo) Delete(ctx context.Context, req *pb.DeleteCoAuthorReq) (rowsAffected int64, err error) {
-	query := `DELETE FROM "coauthor" WHERE id = $1`
+	// rows of old revisions are read-only
+	query := `DELETE FROM "coauthor" t WHERE t.id = $1 AND NOT EXISTS (
+		SELECT 1 FROM "draft" d
+		INNER JOIN "draft" r ON r.group_id = d.group_id AND r.revision > d.revision
+		WHERE d.id = t.article_id
+	) AND ($2 = '' OR t.article_id::VARCHAR = $2)`
 
 	ids := strings.Split(req.Ids, ",")
 
 	for _, id := range ids {
-		result, err := s.db.Exec(ctx, query, id)
+		result, err := s.db.Exec(ctx, query, id, req.GetDraftId())
 		if err != nil {
 			return 0, err
 		}
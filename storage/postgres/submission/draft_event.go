@@ -0,0 +1,201 @@
+package submission
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/pkg/helper"
+	"editory_submission/pkg/util"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"github.com/google/uuid"
+)
+
+type DraftEventRepo struct {
+	db models.DB
+}
+
+func NewDraftEventRepo(db models.DB) storage.DraftEventRepoI {
+	return &DraftEventRepo{
+		db: db,
+	}
+}
+
+func (s *DraftEventRepo) Create(ctx context.Context, req *pb.DraftEvent) (res *pb.DraftEvent, err error) {
+	res = &pb.DraftEvent{}
+
+	query := `INSERT INTO "draft_event" (
+		id,
+		draft_id,
+		actor_id,
+		type,
+		old_status,
+		new_status,
+		old_step,
+		new_step,
+		comment
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4,
+		$5,
+		$6,
+		$7,
+		$8,
+		$9
+	) RETURNING
+		id,
+		draft_id,
+		COALESCE(actor_id::VARCHAR, '') AS actor_id,
+		type,
+		COALESCE(old_status, '') AS old_status,
+		COALESCE(new_status, '') AS new_status,
+		COALESCE(old_step, '') AS old_step,
+		COALESCE(new_step, '') AS new_step,
+		COALESCE(comment, '') AS comment,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at`
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.QueryRow(ctx, query,
+		id.String(),
+		req.GetDraftId(),
+		util.NewNullString(req.GetActorId()),
+		req.GetType(),
+		util.NewNullString(req.GetOldStatus()),
+		util.NewNullString(req.GetNewStatus()),
+		util.NewNullString(req.GetOldStep()),
+		util.NewNullString(req.GetNewStep()),
+		util.NewNullString(req.GetComment()),
+	).Scan(
+		&res.Id,
+		&res.DraftId,
+		&res.ActorId,
+		&res.Type,
+		&res.OldStatus,
+		&res.NewStatus,
+		&res.OldStep,
+		&res.NewStep,
+		&res.Comment,
+		&res.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *DraftEventRepo) GetList(ctx context.Context, req *pb.GetDraftHistoryReq) (res *pb.GetDraftHistoryRes, err error) {
+	res = &pb.GetDraftHistoryRes{}
+	params := make(map[string]interface{})
+	var arr []interface{}
+
+	sortColumns := util.SortColumns{
+		"created_at": "e.created_at",
+		"type":       "e.type",
+	}
+
+	query := `SELECT
+		e.id,
+		e.draft_id,
+		COALESCE(e.actor_id::VARCHAR, '') AS actor_id,
+		e.type,
+		COALESCE(e.old_status, '') AS old_status,
+		COALESCE(e.new_status, '') AS new_status,
+		COALESCE(e.old_step, '') AS old_step,
+		COALESCE(e.new_step, '') AS new_step,
+		COALESCE(e.comment, '') AS comment,
+		TO_CHAR(e.created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		COALESCE(u.id::VARCHAR, ''),
+		COALESCE(u.first_name, ''),
+		COALESCE(u.last_name, ''),
+		COALESCE(u.email, '')
+	FROM
+		"draft_event" e
+	LEFT JOIN "user" u ON e.actor_id = u.id`
+	filter := " WHERE e.draft_id = :draft_id"
+	params["draft_id"] = req.GetDraftId()
+
+	order, err := util.OrderBy(req.GetSort(), sortColumns, " ORDER BY e.created_at")
+	if err != nil {
+		return res, err
+	}
+
+	offset := " OFFSET 0"
+
+	limit := " LIMIT 10"
+
+	if req.GetOffset() > 0 {
+		params["offset"] = req.GetOffset()
+		offset = " OFFSET :offset"
+	}
+
+	if req.GetLimit() > 0 {
+		params["limit"] = req.GetLimit()
+		limit = " LIMIT :limit"
+	}
+
+	createdFilter, err := util.CreatedRange("e.created_at", req.GetCreatedFrom(), req.GetCreatedTo(), params)
+	if err != nil {
+		return res, err
+	}
+	filter += createdFilter
+
+	cQ := `SELECT count(1) FROM "draft_event" e` + filter
+
+	cQ, arr = helper.ReplaceQueryParams(cQ, params)
+
+	err = s.db.QueryRow(ctx, cQ, arr...).Scan(
+		&res.Count,
+	)
+	if err != nil {
+		return res, err
+	}
+
+	q := query + filter + order + offset + limit
+
+	q, arr = helper.ReplaceQueryParams(q, params)
+	rows, err := s.db.Query(ctx, q, arr...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.DraftEvent{}
+		actor := &pb.User{}
+
+		err = rows.Scan(
+			&obj.Id,
+			&obj.DraftId,
+			&obj.ActorId,
+			&obj.Type,
+			&obj.OldStatus,
+			&obj.NewStatus,
+			&obj.OldStep,
+			&obj.NewStep,
+			&obj.Comment,
+			&obj.CreatedAt,
+			&actor.Id,
+			&actor.FirstName,
+			&actor.LastName,
+			&actor.Email,
+		)
+		if err != nil {
+			return res, err
+		}
+
+		if actor.Id != "" {
+			obj.ActorIdData = actor
+		}
+
+		res.Events = append(res.Events, obj)
+	}
+
+	return res, nil
+}
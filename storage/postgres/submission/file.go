@@ -6,16 +6,16 @@ import (
 	"editory_submission/pkg/helper"
 	"editory_submission/pkg/util"
 	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v4/pgxpool"
 	"strings"
 )
 
 type FileRepo struct {
-	db *pgxpool.Pool
+	db models.DB
 }
 
-func NewFileRepo(db *pgxpool.Pool) storage.FileRepoI {
+func NewFileRepo(db models.DB) storage.FileRepoI {
 	return &FileRepo{
 		db: db,
 	}
@@ -131,12 +131,17 @@ func (s FileRepo) GetList(ctx context.Context, req *pb.GetFilesReq) (res *pb.Get
 }
 
 func (s FileRepo) Delete(ctx context.Context, req *pb.DeleteFilesReq) (rowsAffected int64, err error) {
-	query := `DELETE FROM "file" WHERE id = $1`
+	// rows of old revisions are read-only
+	query := `DELETE FROM "file" t WHERE t.id = $1 AND NOT EXISTS (
+		SELECT 1 FROM "draft" d
+		INNER JOIN "draft" r ON r.group_id = d.group_id AND r.revision > d.revision
+		WHERE d.id = t.draft_id
+	) AND ($2 = '' OR t.draft_id::VARCHAR = $2)`
 
 	ids := strings.Split(req.Ids, ",")
 
 	for _, id := range ids {
-		result, err := s.db.Exec(ctx, query, id)
+		result, err := s.db.Exec(ctx, query, id, req.GetDraftId())
 		if err != nil {
 			return 0, err
 		}
@@ -0,0 +1,237 @@
+package submission
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+	"github.com/google/uuid"
+)
+
+type InvitationRepo struct {
+	db models.DB
+}
+
+func NewInvitationRepo(db models.DB) storage.InvitationRepoI {
+	return &InvitationRepo{
+		db: db,
+	}
+}
+
+func (s *InvitationRepo) Create(ctx context.Context, req *pb.ReviewerInvitation) (res *pb.ReviewerInvitation, err error) {
+	res = &pb.ReviewerInvitation{}
+
+	query := `INSERT INTO "reviewer_invitation" (
+		id,
+		draft_checker_id,
+		status,
+		expires_at
+	) VALUES (
+		$1,
+		$2,
+		$3,
+		$4
+	) RETURNING
+		id,
+		draft_checker_id,
+		status,
+		TO_CHAR(expires_at, ` + config.DatabaseQueryTimeLayout + `) AS expires_at,
+		COALESCE(TO_CHAR(responded_at, ` + config.DatabaseQueryTimeLayout + `), '') AS responded_at,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at`
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.QueryRow(ctx, query,
+		id.String(),
+		req.GetDraftCheckerId(),
+		config.INVITATION_STATUS_SENT,
+		req.GetExpiresAt(),
+	).Scan(
+		&res.Id,
+		&res.DraftCheckerId,
+		&res.Status,
+		&res.ExpiresAt,
+		&res.RespondedAt,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *InvitationRepo) Get(ctx context.Context, id string) (res *pb.ReviewerInvitation, err error) {
+	res = &pb.ReviewerInvitation{}
+
+	query := `SELECT
+		id,
+		draft_checker_id,
+		status,
+		TO_CHAR(expires_at, ` + config.DatabaseQueryTimeLayout + `) AS expires_at,
+		COALESCE(TO_CHAR(responded_at, ` + config.DatabaseQueryTimeLayout + `), '') AS responded_at,
+		TO_CHAR(created_at, ` + config.DatabaseQueryTimeLayout + `) AS created_at,
+		TO_CHAR(updated_at, ` + config.DatabaseQueryTimeLayout + `) AS updated_at
+	FROM
+		"reviewer_invitation"
+	WHERE
+		id = $1`
+
+	err = s.db.QueryRow(ctx, query, id).Scan(
+		&res.Id,
+		&res.DraftCheckerId,
+		&res.Status,
+		&res.ExpiresAt,
+		&res.RespondedAt,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Respond answers the invitation only if it is still waiting for the answer and not expired
+func (s *InvitationRepo) Respond(ctx context.Context, id, status string) (rowsAffected int64, err error) {
+	query := `UPDATE "reviewer_invitation" SET
+		status = $2,
+		responded_at = CURRENT_TIMESTAMP,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE id = $1 AND status = $3 AND expires_at > CURRENT_TIMESTAMP`
+
+	result, err := s.db.Exec(ctx, query, id, status, config.INVITATION_STATUS_SENT)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// DeleteExpired removes reviewers whose invitations expired without an answer and records the removal in the draft history.
+// A draft left without a reviewer goes back to the editor, so that it isn't stuck at the REVIEWER step
+func (s *InvitationRepo) DeleteExpired(ctx context.Context) (rowsAffected int64, err error) {
+	query := `DELETE FROM "draft_checker" r
+	USING "reviewer_invitation" i
+	WHERE i.draft_checker_id = r.id AND i.status = $1 AND i.expires_at <= CURRENT_TIMESTAMP
+	RETURNING r.draft_id, COALESCE(r.status::VARCHAR, '')`
+
+	// reviewers who declined don't count, the ones who submitted their review do
+	queryReturn := `UPDATE "draft" d SET
+		step = $2,
+		editor_status = $3,
+		reviewer_status = $4,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE d.id::VARCHAR = ANY($1) AND d.step = $5
+		AND NOT EXISTS (
+			SELECT 1 FROM "draft_checker" r
+			WHERE r.draft_id = d.id AND r.type = $6 AND r.status <> $7
+		)
+	RETURNING d.id, COALESCE(d.status::VARCHAR, '')`
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, query, config.INVITATION_STATUS_SENT)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		events   []*pb.DraftEvent
+		draftIds []string
+	)
+
+	for rows.Next() {
+		event := &pb.DraftEvent{
+			ActorId: config.SYSTEM_USER_ID,
+			Type:    config.DRAFT_EVENT_CHECKER_UPDATE,
+			Comment: "reviewer invitation expired",
+		}
+
+		err = rows.Scan(
+			&event.DraftId,
+			&event.OldStatus,
+		)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		events = append(events, event)
+		draftIds = append(draftIds, event.DraftId)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	deleted := int64(len(events))
+
+	if len(draftIds) > 0 {
+		rows, err = tx.Query(ctx, queryReturn,
+			draftIds,
+			config.DRAFT_STEP_EDITOR,
+			config.DRAFT_CHECK_STATUS_PENDING,
+			config.DRAFT_CHECK_STATUS_NEW,
+			config.DRAFT_STEP_REVIEWER,
+			config.REVIEWER,
+			config.ARTICLE_REVIEWER_STATUS_DECLINED,
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		for rows.Next() {
+			event := &pb.DraftEvent{
+				ActorId: config.SYSTEM_USER_ID,
+				Type:    config.DRAFT_EVENT_DRAFT_UPDATE,
+				OldStep: config.DRAFT_STEP_REVIEWER,
+				NewStep: config.DRAFT_STEP_EDITOR,
+				Comment: "no reviewer left, the draft is returned to the editor",
+			}
+
+			err = rows.Scan(
+				&event.DraftId,
+				&event.OldStatus,
+			)
+			if err != nil {
+				rows.Close()
+				return 0, err
+			}
+			event.NewStatus = event.OldStatus
+
+			events = append(events, event)
+		}
+		rows.Close()
+
+		if err = rows.Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, event := range events {
+		_, err = NewDraftEventRepo(tx).Create(ctx, event)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
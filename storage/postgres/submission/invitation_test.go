@@ -0,0 +1,130 @@
+package submission
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/submission_service"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+func TestInvitationRepoDeleteExpired(t *testing.T) {
+	expired := time.Now().Add(-time.Hour).Format(config.DatabaseTimeLayout)
+	waiting := time.Now().Add(time.Hour).Format(config.DatabaseTimeLayout)
+
+	tests := []struct {
+		name string
+		// other is the status of the second reviewer of the draft, none when empty
+		other          string
+		step           string
+		editorStatus   string
+		reviewerStatus string
+	}{
+		{
+			name:           "last reviewer expired returns the draft to the editor",
+			step:           config.DRAFT_STEP_EDITOR,
+			editorStatus:   config.DRAFT_CHECK_STATUS_PENDING,
+			reviewerStatus: config.DRAFT_CHECK_STATUS_NEW,
+		},
+		{
+			name:           "declined reviewer doesn't keep the draft at the reviewer step",
+			other:          config.ARTICLE_REVIEWER_STATUS_DECLINED,
+			step:           config.DRAFT_STEP_EDITOR,
+			editorStatus:   config.DRAFT_CHECK_STATUS_PENDING,
+			reviewerStatus: config.DRAFT_CHECK_STATUS_NEW,
+		},
+		{
+			name:           "draft with a reviewer left stays at the reviewer step",
+			other:          config.ARTICLE_REVIEWER_STATUS_PENDING,
+			step:           config.DRAFT_STEP_REVIEWER,
+			editorStatus:   config.DRAFT_CHECK_STATUS_PENDING,
+			reviewerStatus: config.DRAFT_CHECK_STATUS_PENDING,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, tx := testTx(t)
+
+			author := createTestUser(ctx, t, tx)
+			editor := createTestUser(ctx, t, tx)
+			draftId := createTestDraft(ctx, t, tx, author, config.ARTICLE_STATUS_PENDING, config.DRAFT_STEP_REVIEWER)
+
+			_, err := NewArticleRepo(tx).Update(ctx, &pb.UpdateArticleReq{
+				Id:             draftId,
+				EditorStatus:   config.DRAFT_CHECK_STATUS_PENDING,
+				ReviewerStatus: config.DRAFT_CHECK_STATUS_PENDING,
+				ActorId:        editor,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			createTestInvitation(ctx, t, tx, draftId, editor, config.ARTICLE_REVIEWER_STATUS_NEW, expired)
+
+			if tt.other != "" {
+				createTestInvitation(ctx, t, tx, draftId, editor, tt.other, waiting)
+			}
+
+			deleted, err := NewInvitationRepo(tx).DeleteExpired(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if deleted < 1 {
+				t.Fatalf("DeleteExpired() = %d, want the expired reviewer deleted", deleted)
+			}
+
+			draft, err := NewArticleRepo(tx).Get(ctx, &pb.GetArticleReq{Id: draftId})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if draft.GetStep() != tt.step || draft.GetEditorStatus() != tt.editorStatus || draft.GetReviewerStatus() != tt.reviewerStatus {
+				t.Errorf("draft step %s, editor %s, reviewer %s, want %s, %s, %s",
+					draft.GetStep(), draft.GetEditorStatus(), draft.GetReviewerStatus(),
+					tt.step, tt.editorStatus, tt.reviewerStatus)
+			}
+
+			expiry := 0
+			for _, val := range draftEvents(ctx, t, tx, draftId) {
+				if val.GetComment() != "reviewer invitation expired" {
+					continue
+				}
+
+				expiry++
+				if val.GetActorId() != config.SYSTEM_USER_ID {
+					t.Errorf("expiry event actor = %q, want the system user", val.GetActorId())
+				}
+			}
+
+			if expiry == 0 {
+				t.Error("no expiry event recorded")
+			}
+		})
+	}
+}
+
+// createTestInvitation assigns a new reviewer with the status to the draft and invites them until expiresAt
+func createTestInvitation(ctx context.Context, t *testing.T, tx pgx.Tx, draftId, editorId, status, expiresAt string) {
+	checker, err := NewReviewerRepo(tx).Create(ctx, &pb.CreateArticleCheckerReq{
+		CheckerId: createTestUser(ctx, t, tx),
+		ArticleId: draftId,
+		Status:    status,
+		Type:      config.REVIEWER,
+		EditorId:  editorId,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewInvitationRepo(tx).Create(ctx, &pb.ReviewerInvitation{
+		DraftCheckerId: checker.GetId(),
+		ExpiresAt:      expiresAt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
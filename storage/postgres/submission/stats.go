@@ -0,0 +1,147 @@
+package submission
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/submission_service"
+	"editory_submission/storage"
+	"editory_submission/storage/postgres/models"
+)
+
+// decisionStatuses are the draft statuses set by the editor's decision
+const decisionStatuses = `('` + config.ARTICLE_STATUS_CONFIRMED + `', '` + config.ARTICLE_STATUS_DENIED + `', '` + config.ARTICLE_STATUS_BACK_FOR_CORRECTION + `')`
+
+// completedReviewStatuses are the checker statuses of the submitted reviews
+const completedReviewStatuses = `('` + config.ARTICLE_REVIEWER_STATUS_APPROVED + `', '` + config.ARTICLE_REVIEWER_STATUS_REJECTED + `', '` + config.ARTICLE_REVIEWER_STATUS_BACK_FOR_CORRECTION + `')`
+
+type StatsRepo struct {
+	db models.DB
+}
+
+func NewStatsRepo(db models.DB) storage.StatsRepoI {
+	return &StatsRepo{
+		db: db,
+	}
+}
+
+// GetJournalStats aggregates the drafts, the decisions and the reviews of the journal.
+// A submission is the group of the revisions of a draft, its dates are taken from the draft history
+func (s *StatsRepo) GetJournalStats(ctx context.Context, journalId string) (res *pb.JournalStats, err error) {
+	res = &pb.JournalStats{
+		JournalId: journalId,
+	}
+
+	res.ByStatus, err = s.countLatestRevisions(ctx, "status", journalId)
+	if err != nil {
+		return nil, err
+	}
+
+	res.ByStep, err = s.countLatestRevisions(ctx, "step", journalId)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `WITH submission AS (
+		SELECT
+			d.group_id,
+			COALESCE(
+				MIN(e.created_at) FILTER (WHERE e.old_status = '` + config.ARTICLE_STATUS_DRAFT + `' AND e.new_status <> '` + config.ARTICLE_STATUS_DRAFT + `'),
+				MIN(d.created_at)
+			) AS submitted_at,
+			MIN(e.created_at) FILTER (WHERE e.new_status IN ` + decisionStatuses + `) AS decided_at,
+			MIN(e.created_at) FILTER (WHERE e.new_status IN ('` + config.ARTICLE_STATUS_CONFIRMED + `', '` + config.ARTICLE_STATUS_DENIED + `')) AS finished_at,
+			MIN(e.created_at) FILTER (WHERE e.new_status = '` + config.ARTICLE_STATUS_CONFIRMED + `') AS accepted_at,
+			bool_or(d.status IN ('` + config.ARTICLE_STATUS_CONFIRMED + `', '` + config.ARTICLE_STATUS_PUBLISHED + `')) AS accepted,
+			bool_or(d.status = '` + config.ARTICLE_STATUS_DENIED + `') AS rejected
+		FROM "draft" d
+		LEFT JOIN "draft_event" e ON e.draft_id = d.id AND e.type = '` + config.DRAFT_EVENT_DRAFT_UPDATE + `'
+		WHERE d.journal_id = $1
+		GROUP BY d.group_id
+		HAVING bool_or(d.status <> '` + config.ARTICLE_STATUS_DRAFT + `')
+	)
+	SELECT
+		count(1),
+		count(1) FILTER (WHERE accepted),
+		count(1) FILTER (WHERE rejected AND NOT accepted),
+		COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM decided_at - submitted_at) / 86400) FILTER (WHERE decided_at >= submitted_at), 0),
+		COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM finished_at - submitted_at) / 86400) FILTER (WHERE finished_at >= submitted_at), 0),
+		COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM accepted_at - submitted_at) / 86400) FILTER (WHERE accepted_at >= submitted_at), 0)
+	FROM submission`
+
+	err = s.db.QueryRow(ctx, query, journalId).Scan(
+		&res.Submissions,
+		&res.Accepted,
+		&res.Rejected,
+		&res.MedianDaysToFirstDecision,
+		&res.MedianDaysToFinalDecision,
+		&res.MedianDaysToAcceptance,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if decided := res.Accepted + res.Rejected; decided > 0 {
+		res.AcceptanceRate = float64(res.Accepted) * 100 / float64(decided)
+	}
+
+	query = `SELECT
+		count(1) FILTER (WHERE r.status IN ` + completedReviewStatuses + `),
+		COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM r.completed_at - r.created_at) / 86400) FILTER (WHERE r.status IN ` + completedReviewStatuses + ` AND r.completed_at IS NOT NULL), 0),
+		COALESCE(AVG(EXTRACT(EPOCH FROM r.completed_at - r.created_at) / 86400) FILTER (WHERE r.status IN ` + completedReviewStatuses + ` AND r.completed_at IS NOT NULL), 0)::FLOAT,
+		count(1) FILTER (WHERE r.status IN ('` + config.ARTICLE_REVIEWER_STATUS_NEW + `', '` + config.ARTICLE_REVIEWER_STATUS_PENDING + `')),
+		count(1) FILTER (WHERE ` + overdueCondition + `)
+	FROM "draft_checker" r
+	INNER JOIN "draft" d ON r.draft_id = d.id
+	WHERE d.journal_id = $1 AND r.type = '` + config.REVIEWER + `'`
+
+	err = s.db.QueryRow(ctx, query, journalId).Scan(
+		&res.CompletedReviews,
+		&res.MedianReviewDays,
+		&res.AvgReviewDays,
+		&res.PendingReviews,
+		&res.OverdueReviews,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// countLatestRevisions counts the latest revisions of the journal drafts by the column
+func (s *StatsRepo) countLatestRevisions(ctx context.Context, column, journalId string) (res []*pb.StatCount, err error) {
+	query := `SELECT
+		COALESCE(d.` + column + `::VARCHAR, ''),
+		count(1)
+	FROM "draft" d
+	INNER JOIN (
+		SELECT DISTINCT
+			FIRST_VALUE(id) OVER (PARTITION BY group_id ORDER BY revision DESC, created_at DESC) AS id
+		FROM "draft"
+		WHERE journal_id = $1
+	) d2 ON d.id = d2.id
+	GROUP BY 1
+	ORDER BY 2 DESC`
+
+	rows, err := s.db.Query(ctx, query, journalId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		obj := &pb.StatCount{}
+
+		err = rows.Scan(
+			&obj.Name,
+			&obj.Count,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, obj)
+	}
+
+	return res, rows.Err()
+}
@@ -2,18 +2,21 @@ package submission
 
 import (
 	"editory_submission/storage"
-	"github.com/jackc/pgx/v4/pgxpool"
+	"editory_submission/storage/postgres/models"
 )
 
 type submissionRepo struct {
-	db       *pgxpool.Pool
+	db       models.DB
 	reviewer storage.ReviewerRepoI
 	article  storage.ArticleRepoI
 	file     storage.FileRepoI
 	coAuthor storage.CoAuthorRepoI
+	event    storage.DraftEventRepoI
+	invite   storage.InvitationRepoI
+	stats    storage.StatsRepoI
 }
 
-func NewSubmissionRepo(db *pgxpool.Pool) storage.SubmissionRepoI {
+func NewSubmissionRepo(db models.DB) storage.SubmissionRepoI {
 	return &submissionRepo{
 		db: db,
 	}
@@ -50,3 +53,27 @@ func (s submissionRepo) CoAuthor() storage.CoAuthorRepoI {
 
 	return s.coAuthor
 }
+
+func (s submissionRepo) DraftEvent() storage.DraftEventRepoI {
+	if s.event == nil {
+		s.event = NewDraftEventRepo(s.db)
+	}
+
+	return s.event
+}
+
+func (s submissionRepo) Invitation() storage.InvitationRepoI {
+	if s.invite == nil {
+		s.invite = NewInvitationRepo(s.db)
+	}
+
+	return s.invite
+}
+
+func (s submissionRepo) Stats() storage.StatsRepoI {
+	if s.stats == nil {
+		s.stats = NewStatsRepo(s.db)
+	}
+
+	return s.stats
+}
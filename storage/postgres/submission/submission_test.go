@@ -0,0 +1,99 @@
+package submission
+
+import (
+	"context"
+	"editory_submission/config"
+	pb "editory_submission/genproto/submission_service"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+)
+
+// testTx returns a transaction of the database from POSTGRES_HOST which is rolled back when the test ends,
+// the test is skipped when the database isn't set or available
+func testTx(t *testing.T) (context.Context, pgx.Tx) {
+	if os.Getenv("POSTGRES_HOST") == "" {
+		t.Skip("set POSTGRES_HOST to run the database tests")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	cfg := config.Load()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		cfg.PostgresUser,
+		cfg.PostgresPassword,
+		cfg.PostgresHost,
+		cfg.PostgresPort,
+		cfg.PostgresDatabase,
+	))
+	if err != nil {
+		t.Skipf("postgres is not available: %v", err)
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = tx.Rollback(context.Background())
+		_ = conn.Close(context.Background())
+	})
+
+	return ctx, tx
+}
+
+// createTestUser inserts a user with a random email
+func createTestUser(ctx context.Context, t *testing.T, tx pgx.Tx) string {
+	id := uuid.NewString()
+
+	_, err := tx.Exec(ctx, `INSERT INTO "user" (id, first_name, email) VALUES ($1, 'Test', $2)`, id, id+"@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return id
+}
+
+// createTestDraft inserts a journal and a draft of the author in it with the status
+func createTestDraft(ctx context.Context, t *testing.T, tx pgx.Tx, authorId, status, step string) string {
+	journalId := uuid.NewString()
+
+	_, err := tx.Exec(ctx, `INSERT INTO "journal" (id, title, isbn, author_id) VALUES ($1, 'Test journal', $1, $2)`, journalId, authorId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	draft, err := NewArticleRepo(tx).Create(ctx, &pb.CreateArticleReq{
+		JournalId: journalId,
+		Title:     "Test draft",
+		AuthorId:  authorId,
+		Status:    status,
+		Step:      step,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return draft.GetId()
+}
+
+// draftEvents returns the history of the draft in the order it was recorded
+func draftEvents(ctx context.Context, t *testing.T, tx pgx.Tx, draftId string) []*pb.DraftEvent {
+	res, err := NewDraftEventRepo(tx).GetList(ctx, &pb.GetDraftHistoryReq{
+		DraftId: draftId,
+		Limit:   100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return res.GetEvents()
+}
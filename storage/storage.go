@@ -7,14 +7,17 @@ import (
 	"editory_submission/genproto/notification_service"
 	"editory_submission/genproto/submission_service"
 	"editory_submission/storage/postgres/models"
+	"time"
 )
 
 type StorageI interface {
 	CloseDB()
+	WithTx(ctx context.Context, fn func(StorageI) error) error
 	Auth() AuthRepoI
 	Content() ContentRepoI
 	Notification() NotificationRepoI
 	Submission() SubmissionRepoI
+	Analytics() AnalyticsRepoI
 }
 
 type AuthRepoI interface {
@@ -22,6 +25,7 @@ type AuthRepoI interface {
 	Session() SessionRepoI
 	Role() RoleRepoI
 	Keyword() KeywordRepoI
+	Permission() PermissionRepoI
 }
 
 type ContentRepoI interface {
@@ -32,11 +36,14 @@ type ContentRepoI interface {
 	University() UniversityRepoI
 	Subject() SubjectRepoI
 	Article() ContentArticleRepoI
+	ReviewForm() ReviewFormRepoI
+	Search() SearchRepoI
 }
 
 type NotificationRepoI interface {
 	Notification() NotifyRepoI
 	EmailTemplate() EmailTemplateRepoI
+	UserNotification() UserNotificationRepoI
 }
 
 type SubmissionRepoI interface {
@@ -44,6 +51,9 @@ type SubmissionRepoI interface {
 	File() FileRepoI
 	CoAuthor() CoAuthorRepoI
 	Reviewer() ReviewerRepoI
+	DraftEvent() DraftEventRepoI
+	Invitation() InvitationRepoI
+	Stats() StatsRepoI
 }
 
 type UserRepoI interface {
@@ -76,6 +86,7 @@ type JournalRepoI interface {
 	Get(ctx context.Context, in *cs_pb.PrimaryKey) (*cs_pb.Journal, error)
 	GetList(ctx context.Context, in *cs_pb.GetList) (*cs_pb.GetJournalListRes, error)
 	Update(ctx context.Context, in *cs_pb.Journal) (*cs_pb.Journal, error)
+	UpdateMetrics(ctx context.Context, id, acceptanceRate, submissionToFinalDecision string) (rowsAffected int64, err error)
 	Delete(ctx context.Context, in *cs_pb.PrimaryKey) (rowsAffected int64, err error)
 	UpsertJournalData(ctx context.Context, in *cs_pb.JournalData) (*cs_pb.JournalData, error)
 	GetJournalData(ctx context.Context, in *cs_pb.PrimaryKey) ([]*cs_pb.JournalData, error)
@@ -98,6 +109,7 @@ type ContentArticleRepoI interface {
 	GetList(ctx context.Context, in *cs_pb.GetArticleListReq) (*cs_pb.GetArticleListRes, error)
 	Update(ctx context.Context, in *cs_pb.UpdateArticleReq) (*cs_pb.UpdateArticleRes, error)
 	Delete(ctx context.Context, in *cs_pb.DeleteArticleReq) (rowsAffected int64, err error)
+	SetKeywords(ctx context.Context, articleId string, keywordIds []string) error
 }
 
 type CountryAndCityRepoI interface {
@@ -121,6 +133,18 @@ type SubjectRepoI interface {
 	Delete(ctx context.Context, in *cs_pb.DeleteSubjectReq) (rowsAffected int64, err error)
 }
 
+type SearchRepoI interface {
+	Search(ctx context.Context, in *cs_pb.SearchReq) (*cs_pb.SearchRes, error)
+}
+
+type ReviewFormRepoI interface {
+	Create(ctx context.Context, in *cs_pb.CreateReviewFormReq) (*cs_pb.ReviewForm, error)
+	Get(ctx context.Context, in *cs_pb.GetReviewFormReq) (*cs_pb.ReviewForm, error)
+	GetList(ctx context.Context, in *cs_pb.GetReviewFormListReq) (*cs_pb.GetReviewFormListRes, error)
+	Update(ctx context.Context, in *cs_pb.UpdateReviewFormReq) (*cs_pb.ReviewForm, error)
+	Delete(ctx context.Context, in *cs_pb.DeleteReviewFormReq) (rowsAffected int64, err error)
+}
+
 type RoleRepoI interface {
 	Create(ctx context.Context, req *pb.Role) (res *pb.Role, err error)
 	Get(ctx context.Context, req *pb.GetRoleReq) (res *pb.Role, err error)
@@ -129,12 +153,26 @@ type RoleRepoI interface {
 	Delete(ctx context.Context, req *pb.DeleteRoleReq) (rowsAffected int64, err error)
 }
 
+type PermissionRepoI interface {
+	Create(ctx context.Context, req *pb.CreatePermissionReq) (res *pb.Permission, err error)
+	Get(ctx context.Context, req *pb.GetPermissionReq) (res *pb.Permission, err error)
+	GetList(ctx context.Context, req *pb.GetPermissionListReq) (res *pb.GetPermissionListRes, err error)
+	Update(ctx context.Context, req *pb.Permission) (res *pb.Permission, err error)
+	Delete(ctx context.Context, req *pb.DeletePermissionReq) (rowsAffected int64, err error)
+	HasAccess(ctx context.Context, roleType, path, method string) (hasAccess bool, err error)
+}
+
 type NotifyRepoI interface {
 	Create(ctx context.Context, in *notification_service.CreateNotificationReq) (*notification_service.CreateNotificationRes, error)
 	Get(ctx context.Context, in *notification_service.GetNotificationReq) (*notification_service.GetNotificationRes, error)
 	GetList(ctx context.Context, in *notification_service.GetNotificationListReq) (*notification_service.GetNotificationListRes, error)
 	Update(ctx context.Context, in *notification_service.UpdateNotificationReq) (*notification_service.UpdateNotificationRes, error)
 	Delete(ctx context.Context, in *notification_service.DeleteNotificationReq) (rowsAffected int64, err error)
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*notification_service.Notification, error)
+	Release(ctx context.Context, ids []string) error
+	MarkSent(ctx context.Context, id string) error
+	Retry(ctx context.Context, id, lastError string, delay time.Duration) error
+	MarkDead(ctx context.Context, id, lastError string) error
 }
 
 type EmailTemplateRepoI interface {
@@ -145,12 +183,20 @@ type EmailTemplateRepoI interface {
 	Delete(ctx context.Context, in *notification_service.DeleteEmailTmpReq) (rowsAffected int64, err error)
 }
 
+type UserNotificationRepoI interface {
+	Create(ctx context.Context, in *notification_service.CreateUserNotificationReq) (*notification_service.UserNotification, error)
+	GetList(ctx context.Context, in *notification_service.GetUserNotificationListReq) (*notification_service.GetUserNotificationListRes, error)
+	MarkRead(ctx context.Context, id, userId string) (rowsAffected int64, err error)
+	MarkAllRead(ctx context.Context, userId string) (rowsAffected int64, err error)
+}
+
 type KeywordRepoI interface {
 	Create(ctx context.Context, in *pb.CreateKeywordReq) (*pb.CreateKeywordRes, error)
 	Get(ctx context.Context, in *pb.GetKeywordReq) (*pb.GetKeywordRes, error)
 	GetList(ctx context.Context, in *pb.GetKeywordListReq) (*pb.GetKeywordListRes, error)
 	Update(ctx context.Context, in *pb.UpdateKeywordReq) (*pb.UpdateKeywordRes, error)
 	Delete(ctx context.Context, in *pb.DeleteKeywordReq) (rowsAffected int64, err error)
+	GetOrCreate(ctx context.Context, keywords []string) (ids []string, err error)
 }
 
 type ArticleRepoI interface {
@@ -159,6 +205,8 @@ type ArticleRepoI interface {
 	GetList(ctx context.Context, in *submission_service.GetArticleListReq) (*submission_service.GetArticleListRes, error)
 	Update(ctx context.Context, in *submission_service.UpdateArticleReq) (rowsAffected int64, err error)
 	Delete(ctx context.Context, in *submission_service.DeleteArticleReq) (rowsAffected int64, err error)
+	IsLatestRevision(ctx context.Context, id string) (bool, error)
+	SetKeywords(ctx context.Context, draftId string, keywordIds []string) error
 }
 
 type FileRepoI interface {
@@ -183,6 +231,31 @@ type ReviewerRepoI interface {
 	GetList(ctx context.Context, in *submission_service.GetArticleCheckerListReq) (*submission_service.GetArticleCheckerListRes, error)
 	Update(ctx context.Context, in *submission_service.UpdateArticleCheckerReq) (rowsAffected int64, err error)
 	Delete(ctx context.Context, in *submission_service.DeleteArticleCheckerReq) (rowsAffected int64, err error)
+	MarkReminded(ctx context.Context, remindDays int) ([]*models.ReviewDeadline, error)
+	MarkOverdue(ctx context.Context) ([]*models.ReviewDeadline, error)
+	GetSuggestions(ctx context.Context, in *submission_service.GetReviewerSuggestionsReq) (*submission_service.GetReviewerSuggestionsRes, error)
+	DeclareConflict(ctx context.Context, id string, hasConflict bool, statement string) (rowsAffected int64, err error)
+	GetConflicts(ctx context.Context, draftId, checkerId string) ([]string, error)
+}
+
+type DraftEventRepoI interface {
+	Create(ctx context.Context, in *submission_service.DraftEvent) (*submission_service.DraftEvent, error)
+	GetList(ctx context.Context, in *submission_service.GetDraftHistoryReq) (*submission_service.GetDraftHistoryRes, error)
+}
+
+type InvitationRepoI interface {
+	Create(ctx context.Context, in *submission_service.ReviewerInvitation) (*submission_service.ReviewerInvitation, error)
+	Get(ctx context.Context, id string) (*submission_service.ReviewerInvitation, error)
+	Respond(ctx context.Context, id, status string) (rowsAffected int64, err error)
+	DeleteExpired(ctx context.Context) (rowsAffected int64, err error)
+}
+
+type AnalyticsRepoI interface {
+	GetPlatformStats(ctx context.Context, dateFrom, dateTo, granularity string) (*cs_pb.PlatformStats, error)
+}
+
+type StatsRepoI interface {
+	GetJournalStats(ctx context.Context, journalId string) (*submission_service.JournalStats, error)
 }
 
 type JournalAuthorRepoI interface {